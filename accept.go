@@ -0,0 +1,157 @@
+package semver
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Request headers read by Matcher.MatchHeader, in order of precedence.
+const (
+	HeaderAcceptVersion = "Accept-Version"
+	HeaderAPIVersion    = "X-API-Version"
+)
+
+// ParseAcceptVersion parses an Accept-Version style header value into
+// constraints in order of preference:
+//
+//	Accept-Version: ^2.1, ~1.4;q=0.5, 1.x;q=0.1
+//
+// Entries are comma separated (so, unlike ParseConstraint, a comma does not
+// join comparators; use spaces: ">=1.2 <2"), each may carry an HTTP quality
+// parameter "q" (default 1). Entries are ordered by descending quality,
+// keeping header order among equal ones; q=0 entries are dropped. An empty
+// header yields no constraints. Errors wrap ErrInvalidConstraint.
+func ParseAcceptVersion(header string) ([]Constraint, error) {
+	type entry struct {
+		c Constraint
+		q float64
+	}
+
+	var entries []entry
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		expr, params, _ := strings.Cut(part, ";")
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+			if !strings.EqualFold(strings.TrimSpace(name), "q") {
+				continue
+			}
+
+			f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || f < 0 || f > 1 {
+				return nil, fmt.Errorf("%w: bad quality in %q", ErrInvalidConstraint, part)
+			}
+			q = f
+		}
+
+		c, ok := ParseConstraint(expr)
+		if !ok || strings.TrimSpace(expr) == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidConstraint, part)
+		}
+		if q > 0 {
+			entries = append(entries, entry{c: c, q: q})
+		}
+	}
+
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		switch {
+		case a.q > b.q:
+			return -1
+		case a.q < b.q:
+			return 1
+		}
+		return 0
+	})
+
+	out := make([]Constraint, len(entries))
+	for i, e := range entries {
+		out[i] = e.c
+	}
+
+	return out, nil
+}
+
+// Matcher maps Accept-Version headers to the best available API version.
+// It is immutable after NewMatcher and safe for concurrent use:
+//
+//	m := semver.NewMatcher(semver.List{v1, v2, v3})
+//	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+//		v, err := m.MatchHeader(r.Header.Get)
+//		...
+//	})
+type Matcher struct {
+	// valid versions in ascending order
+	versions List
+}
+
+// NewMatcher returns a Matcher choosing among the valid versions of
+// available. The list is copied.
+func NewMatcher(available List) *Matcher {
+	vs := make(List, 0, len(available))
+	for _, v := range available {
+		if v.Valid {
+			vs = append(vs, v)
+		}
+	}
+	vs.Sort()
+
+	return &Matcher{versions: vs}
+}
+
+// Versions returns a copy of the registered versions in ascending order.
+func (m *Matcher) Versions() List {
+	return slices.Clone(m.versions)
+}
+
+// Match returns the highest registered version satisfying the most
+// preferred constraint of header (see ParseAcceptVersion) that any version
+// satisfies. An empty header selects the highest release (or the highest
+// version if there are only prereleases). Fails with ErrNoMatch if nothing
+// matches, or with the ParseAcceptVersion error.
+func (m *Matcher) Match(header string) (Semver, error) {
+	cs, err := ParseAcceptVersion(header)
+	if err != nil {
+		return Semver{}, err
+	}
+
+	if len(cs) == 0 {
+		for i := len(m.versions) - 1; i >= 0; i-- {
+			if !m.versions[i].HasPre() {
+				return m.versions[i], nil
+			}
+		}
+		if len(m.versions) > 0 {
+			return m.versions[len(m.versions)-1], nil
+		}
+		return Semver{}, ErrNoMatch
+	}
+
+	for _, c := range cs {
+		for i := len(m.versions) - 1; i >= 0; i-- {
+			if c.Check(m.versions[i]) {
+				return m.versions[i], nil
+			}
+		}
+	}
+
+	return Semver{}, ErrNoMatch
+}
+
+// MatchHeader is like Match for the first non-empty header of
+// HeaderAcceptVersion and HeaderAPIVersion looked up with get, which fits
+// http.Header.Get.
+func (m *Matcher) MatchHeader(get func(name string) string) (Semver, error) {
+	header := get(HeaderAcceptVersion)
+	if strings.TrimSpace(header) == "" {
+		header = get(HeaderAPIVersion)
+	}
+
+	return m.Match(header)
+}
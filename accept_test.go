@@ -0,0 +1,95 @@
+package semver
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestParseAcceptVersion(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []string
+	}{
+		{"^2.1, ~1.4;q=0.5, 1.x;q=0.1", []string{"^2.1", "~1.4", "1.x"}},
+		{"1.x;q=0.1, ~1.4; Q=0.5, ^2.1", []string{"^2.1", "~1.4", "1.x"}},
+		{">=1.2 <2, ^3", []string{">=1.2 <2", "^3"}},
+		{"^1;q=0, ^2", []string{"^2"}},
+		{"^1;charset=x", []string{"^1"}},
+		{"", nil},
+		{" , ", nil},
+	}
+
+	for _, tt := range tests {
+		cs, err := ParseAcceptVersion(tt.header)
+		if err != nil {
+			t.Errorf("ParseAcceptVersion(%q) error: %v", tt.header, err)
+			continue
+		}
+
+		got := make([]string, len(cs))
+		for i, c := range cs {
+			got[i] = c.String()
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("ParseAcceptVersion(%q) = %q, want %q", tt.header, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("ParseAcceptVersion(%q) = %q, want %q", tt.header, got, tt.want)
+				break
+			}
+		}
+	}
+
+	for _, header := range []string{"^bad", "^1;q=2", "^1;q=x", ";q=1"} {
+		if _, err := ParseAcceptVersion(header); !errors.Is(err, ErrInvalidConstraint) {
+			t.Errorf("ParseAcceptVersion(%q) error = %v, want ErrInvalidConstraint", header, err)
+		}
+	}
+}
+
+func TestMatcher(t *testing.T) {
+	m := NewMatcher(mustList("2.0.0", "1.4.2", "1.5.0", "3.0.0-beta.1", "bad", "1.4.9"))
+
+	tests := []struct {
+		header string
+		want   string
+		err    error
+	}{
+		{"", "2.0.0", nil},
+		{"~1.4", "1.4.9", nil},
+		{"^3, ~1.4;q=0.5", "1.4.9", nil},
+		{">=3.0.0-beta <4", "3.0.0-beta.1", nil},
+		{"^4", "", ErrNoMatch},
+		{"^bad", "", ErrInvalidConstraint},
+	}
+
+	for _, tt := range tests {
+		got, err := m.Match(tt.header)
+		if !errors.Is(err, tt.err) || got.Original != tt.want {
+			t.Errorf("Match(%q) = %q, %v, want %q, %v", tt.header, got.Original, err, tt.want, tt.err)
+		}
+	}
+
+	h := http.Header{}
+	h.Set(HeaderAPIVersion, "^1")
+	if got, err := m.MatchHeader(h.Get); err != nil || got.Original != "1.5.0" {
+		t.Errorf("MatchHeader(X-API-Version) = %q, %v", got.Original, err)
+	}
+	h.Set(HeaderAcceptVersion, "~1.4")
+	if got, err := m.MatchHeader(h.Get); err != nil || got.Original != "1.4.9" {
+		t.Errorf("MatchHeader(Accept-Version) = %q, %v", got.Original, err)
+	}
+
+	if got, err := NewMatcher(mustList("1.0.0-rc.1")).Match(""); err != nil || got.Original != "1.0.0-rc.1" {
+		t.Errorf("Match on prereleases only = %q, %v", got.Original, err)
+	}
+	if _, err := NewMatcher(nil).Match(""); !errors.Is(err, ErrNoMatch) {
+		t.Errorf("Match on empty Matcher error = %v", err)
+	}
+	if got := m.Versions(); len(got) != 5 || got[0].Original != "1.4.2" {
+		t.Errorf("Versions = %v", got)
+	}
+}
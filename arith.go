@@ -0,0 +1,56 @@
+package semver
+
+import "math"
+
+// Add returns v with the given deltas added to MAJOR, MINOR and PATCH
+// independently (1.5.3 Add(0, -3, 0) is 1.2.3, Add(2, 0, 0) is 3.5.3).
+// Unlike Bump*, lower components are not reset. The result is a release:
+// revision, prerelease and build metadata are cleared.
+//
+// Returns (zero, false) if v is invalid or a component would become negative
+// or overflow int.
+func (v Semver) Add(major, minor, patch int) (Semver, bool) {
+	if !v.Valid {
+		return Semver{Original: v.Original, Valid: false}, false
+	}
+
+	nv := v
+	var ok1, ok2, ok3 bool
+	nv.Major, ok1 = addComponent(v.Major, major)
+	nv.Minor, ok2 = addComponent(v.Minor, minor)
+	nv.Patch, ok3 = addComponent(v.Patch, patch)
+	if !ok1 || !ok2 || !ok3 {
+		return Semver{Original: v.Original, Valid: false}, false
+	}
+
+	nv.Revision = 0
+	nv.Prerelease, nv.Build = "", ""
+	nv.Flags |= FlagHasMajor | FlagHasMinor | FlagHasPatch
+	nv.Flags &^= (FlagHasRevision | FlagHasPre | FlagHasBuild)
+	nv.Original = nv.Print(PrintMaskDefault)
+
+	return nv, true
+}
+
+// Subtract is Add with negated deltas: v.Subtract(0, 3, 0) is "three minors ago".
+func (v Semver) Subtract(major, minor, patch int) (Semver, bool) {
+	if major == math.MinInt || minor == math.MinInt || patch == math.MinInt {
+		return Semver{Original: v.Original, Valid: false}, false
+	}
+
+	return v.Add(-major, -minor, -patch)
+}
+
+// addComponent returns n+d, reporting false on underflow below 0 or overflow.
+func addComponent(n, d int) (int, bool) {
+	if d > 0 && n > math.MaxInt-d {
+		return 0, false
+	}
+
+	r := n + d
+	if r < 0 {
+		return 0, false
+	}
+
+	return r, true
+}
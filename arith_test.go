@@ -0,0 +1,49 @@
+package semver
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		in                  string
+		major, minor, patch int
+		want                string
+		ok                  bool
+	}{
+		{"1.5.3", 0, -3, 0, "1.2.3", true},
+		{"v1.5.3-rc.1+b", 2, 0, 0, "v3.5.3", true},
+		{"1.2", 0, 0, 1, "1.2.1", true},
+		{"1.2.3", 0, 0, 0, "1.2.3", true},
+		{"1.2.3", 0, -3, 0, "", false},
+		{"1.2.3", -2, 0, 0, "", false},
+		{"1.2.3", math.MaxInt, 0, 0, "", false},
+		{"bad", 1, 0, 0, "", false},
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.in)
+		got, ok := v.Add(tt.major, tt.minor, tt.patch)
+		if ok != tt.ok || (ok && got.Original != tt.want) {
+			t.Errorf("%q.Add(%d, %d, %d) = %q, %v; want %q, %v",
+				tt.in, tt.major, tt.minor, tt.patch, got.Original, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestSubtract(t *testing.T) {
+	v, _ := Parse("2.5.0")
+
+	got, ok := v.Subtract(0, 3, 0)
+	if !ok || got.Original != "2.2.0" {
+		t.Fatalf("Subtract(0, 3, 0) = %q, %v", got.Original, ok)
+	}
+
+	if _, ok := v.Subtract(math.MinInt, 0, 0); ok {
+		t.Fatalf("Subtract(MinInt) accepted")
+	}
+	if _, ok := v.Subtract(0, 6, 0); ok {
+		t.Fatalf("Subtract underflow accepted")
+	}
+}
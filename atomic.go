@@ -0,0 +1,53 @@
+package semver
+
+import "sync/atomic"
+
+// SemverValue holds a Semver that can be read and replaced atomically, e.g.
+// a server's advertised version or minimum supported client version that is
+// hot-reloaded at runtime. The zero value holds the zero (invalid) Semver.
+// A SemverValue must not be copied after first use.
+type SemverValue struct {
+	p atomic.Pointer[Semver]
+}
+
+// Load returns the current version.
+func (sv *SemverValue) Load() Semver {
+	if p := sv.p.Load(); p != nil {
+		return *p
+	}
+
+	return Semver{}
+}
+
+// Store sets the current version to v unconditionally.
+func (sv *SemverValue) Store(v Semver) {
+	sv.p.Store(&v)
+}
+
+// Swap stores v and returns the previous version.
+func (sv *SemverValue) Swap(v Semver) Semver {
+	if old := sv.p.Swap(&v); old != nil {
+		return *old
+	}
+
+	return Semver{}
+}
+
+// CompareAndSwapIfNewer stores v only if it is valid and has higher
+// precedence than the current version, and reports whether it did.
+// Concurrent callers never move the version backwards.
+func (sv *SemverValue) CompareAndSwapIfNewer(v Semver) bool {
+	if !v.Valid {
+		return false
+	}
+
+	for {
+		cur := sv.p.Load()
+		if cur != nil && !v.IsGreater(*cur) {
+			return false
+		}
+		if sv.p.CompareAndSwap(cur, &v) {
+			return true
+		}
+	}
+}
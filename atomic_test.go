@@ -0,0 +1,54 @@
+package semver
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSemverValue(t *testing.T) {
+	var sv SemverValue
+	if got := sv.Load(); got.Valid {
+		t.Errorf("zero Load = %+v", got)
+	}
+
+	if !sv.CompareAndSwapIfNewer(MustParse("1.2.0")) {
+		t.Error("CompareAndSwapIfNewer into empty value failed")
+	}
+	if sv.CompareAndSwapIfNewer(MustParse("1.1.0")) || sv.CompareAndSwapIfNewer(MustParse("v1.2.0+b")) {
+		t.Error("CompareAndSwapIfNewer stored a version that is not newer")
+	}
+	if sv.CompareAndSwapIfNewer(Semver{Original: "bad"}) {
+		t.Error("CompareAndSwapIfNewer stored an invalid version")
+	}
+
+	sv.Store(MustParse("0.9.0"))
+	if old := sv.Swap(MustParse("3.0.0")); old.Original != "0.9.0" {
+		t.Errorf("Swap returned %q, want 0.9.0", old.Original)
+	}
+	if got := sv.Load(); got.Original != "3.0.0" {
+		t.Errorf("Load = %q, want 3.0.0", got.Original)
+	}
+
+	var empty SemverValue
+	if old := empty.Swap(MustParse("1.0.0")); old.Valid {
+		t.Errorf("Swap on zero value returned %+v", old)
+	}
+}
+
+func TestSemverValue_Concurrent(t *testing.T) {
+	var sv SemverValue
+	var wg sync.WaitGroup
+	for i := 0; i < 64; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sv.CompareAndSwapIfNewer(New(1, i, 0))
+			_ = sv.Load()
+		}(i)
+	}
+	wg.Wait()
+
+	if got := sv.Load(); got.Minor != 63 {
+		t.Errorf("Load = %q, want 1.63.0", got.Original)
+	}
+}
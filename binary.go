@@ -0,0 +1,197 @@
+package semver
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// binaryVersion is the current MarshalBinary layout version.
+const binaryVersion = 1
+
+// binary header bits
+const (
+	binValid  = 1 << iota // version is valid
+	binUpperV             // original prefix was 'V'
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler with a compact layout:
+//
+//	byte     layout version (1)
+//	byte     header (valid, uppercase 'V' prefix)
+//	byte     Flags
+//	valid:   uvarint [Epoch,] Major, Minor, Patch[, Revision]; uvarint len + Prerelease; uvarint len + Build
+//	invalid: uvarint len + Original
+//
+// Original is not stored for valid versions, UnmarshalBinary renders it
+// back from the components present in the input. The first byte allows
+// future layouts to be decoded alongside this one.
+func (v Semver) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, 3+5*binary.MaxVarintLen64+len(v.Prerelease)+len(v.Build)+2))
+}
+
+// AppendBinary appends the MarshalBinary encoding of v to dst.
+func (v Semver) AppendBinary(dst []byte) ([]byte, error) {
+	var hdr byte
+	if v.Valid {
+		hdr |= binValid
+	}
+	if v.HasV() && v.prefixByte() == 'V' {
+		hdr |= binUpperV
+	}
+
+	dst = append(dst, binaryVersion, hdr, byte(v.Flags))
+	if !v.Valid {
+		return appendBinaryString(dst, v.Original), nil
+	}
+
+	if v.Flags&FlagHasEpoch != 0 {
+		dst = binary.AppendUvarint(dst, uint64(v.Epoch))
+	}
+	dst = binary.AppendUvarint(dst, uint64(v.Major))
+	dst = binary.AppendUvarint(dst, uint64(v.Minor))
+	dst = binary.AppendUvarint(dst, uint64(v.Patch))
+	if v.Flags&FlagHasRevision != 0 {
+		dst = binary.AppendUvarint(dst, uint64(v.Revision))
+	}
+	dst = appendBinaryString(dst, v.Prerelease)
+	dst = appendBinaryString(dst, v.Build)
+
+	return dst, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes data produced by MarshalBinary without re-parsing, but
+// validates prerelease and build identifiers like Parse and rejects flags
+// that disagree with the fields, so corrupted input never decodes into a
+// valid version Parse would reject.
+func (v *Semver) UnmarshalBinary(data []byte) error {
+	if len(data) < 3 {
+		return ErrBinaryMalformed
+	}
+	if data[0] != binaryVersion {
+		return ErrBinaryVersion
+	}
+
+	hdr, flags := data[1], Flags(data[2])
+	data = data[3:]
+
+	if hdr&binValid == 0 {
+		orig, rest, ok := readBinaryString(data)
+		if !ok || len(rest) != 0 {
+			return ErrBinaryMalformed
+		}
+		*v = Semver{Original: orig, Flags: flags}
+		return nil
+	}
+
+	// epoch, major, minor, patch, revision
+	var nums [5]int
+	first, end := 1, 4
+	if flags&FlagHasEpoch != 0 {
+		first = 0
+	}
+	if flags&FlagHasRevision != 0 {
+		end = 5
+	}
+	for i := first; i < end; i++ {
+		n, k := binary.Uvarint(data)
+		if k <= 0 || n > uint64(^uint(0)>>1) {
+			return ErrBinaryMalformed
+		}
+		nums[i] = int(n)
+		data = data[k:]
+	}
+
+	pre, data, ok := readBinaryString(data)
+	if !ok {
+		return ErrBinaryMalformed
+	}
+	build, data, ok := readBinaryString(data)
+	if !ok || len(data) != 0 {
+		return ErrBinaryMalformed
+	}
+
+	nv := Semver{
+		Epoch:      nums[0],
+		Major:      nums[1],
+		Minor:      nums[2],
+		Patch:      nums[3],
+		Revision:   nums[4],
+		Prerelease: pre,
+		Build:      build,
+		Flags:      flags,
+		Valid:      true,
+	}
+	if !binaryConsistent(nv) {
+		return ErrBinaryMalformed
+	}
+
+	// render Original from the components present in the input
+	mask := PrintEpoch | PrintMajor | PrintRevision | PrintPrerelease | PrintBuild
+	if flags&FlagHasMinor != 0 {
+		mask |= PrintMinor
+	}
+	if flags&FlagHasPatch != 0 {
+		mask |= PrintPatch
+	}
+	if flags&FlagHasV != 0 {
+		mask |= PrintPrefixV
+	} else {
+		mask |= PrintPrefixNoV
+	}
+
+	orig := nv.AppendPrint(nil, mask)
+	if hdr&binUpperV != 0 {
+		if i := bytes.IndexByte(orig, 'v'); i >= 0 {
+			orig[i] = 'V'
+		}
+	}
+	nv.Original = string(orig)
+
+	*v = nv
+	return nil
+}
+
+// binaryConsistent reports whether the decoded fields of v agree with its
+// Flags and its identifiers are valid as Parse would accept them.
+func binaryConsistent(v Semver) bool {
+	f := v.Flags
+	switch {
+	case f&FlagHasMajor == 0,
+		f&FlagHasMinor == 0 && (v.Minor != 0 || f&FlagHasPatch != 0),
+		f&FlagHasPatch == 0 && (v.Patch != 0 || f&(FlagHasRevision|FlagHasPre|FlagHasBuild) != 0),
+		(f&FlagHasPre != 0) != (v.Prerelease != ""),
+		(f&FlagHasBuild != 0) != (v.Build != ""):
+		return false
+	}
+
+	if v.Prerelease != "" {
+		if _, end, _, ok := parsePrerelease(v.Prerelease, 0); !ok || end != len(v.Prerelease) {
+			return false
+		}
+	}
+	if v.Build != "" {
+		if _, _, _, ok := parseBuild(v.Build, 0); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// appendBinaryString appends s prefixed with its uvarint length.
+func appendBinaryString(dst []byte, s string) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(s)))
+	return append(dst, s...)
+}
+
+// readBinaryString reads a uvarint length-prefixed string.
+func readBinaryString(data []byte) (string, []byte, bool) {
+	n, k := binary.Uvarint(data)
+	if k <= 0 || n > uint64(len(data)-k) {
+		return "", nil, false
+	}
+
+	end := k + int(n)
+	return string(data[k:end]), data[end:], true
+}
@@ -0,0 +1,128 @@
+package semver
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+)
+
+// TestBinaryRoundTrip checks MarshalBinary/UnmarshalBinary for the test table.
+func TestBinaryRoundTrip(t *testing.T) {
+	inputs := []string{"V1.2.3-rc.1+meta", "1.2", "v1"}
+	for _, tt := range tests {
+		inputs = append(inputs, tt.in)
+	}
+
+	vs := make([]Semver, 0, len(inputs)+1)
+	for _, in := range inputs {
+		v, _ := Parse(in)
+		vs = append(vs, v)
+	}
+	vs = append(vs,
+		Must(ParseWith("v1.2.3.4-rc.1", ParseOptions{AllowRevision: true})),
+		Must(ParseWith("12:V1.2", ParseOptions{AllowEpoch: true})),
+	)
+
+	for _, v := range vs {
+		data, err := v.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%q): %v", v.Original, err)
+		}
+
+		var got Semver
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary(%q): %v", v.Original, err)
+		}
+		if got != v {
+			t.Errorf("round trip %q: got %+v, want %+v", v.Original, got, v)
+		}
+	}
+}
+
+// TestBinaryErrors checks rejection of foreign and truncated data.
+func TestBinaryErrors(t *testing.T) {
+	data, _ := MustParse("1.2.3-rc.1").MarshalBinary()
+
+	var v Semver
+	if err := v.UnmarshalBinary(append([]byte{9}, data[1:]...)); !errors.Is(err, ErrBinaryVersion) {
+		t.Errorf("unknown layout: err = %v, want ErrBinaryVersion", err)
+	}
+	for i := 0; i < len(data); i++ {
+		if err := v.UnmarshalBinary(data[:i]); !errors.Is(err, ErrBinaryMalformed) {
+			t.Errorf("truncated to %d bytes: err = %v, want ErrBinaryMalformed", i, err)
+		}
+	}
+	if err := v.UnmarshalBinary(append(data, 0)); !errors.Is(err, ErrBinaryMalformed) {
+		t.Errorf("trailing bytes: err = %v, want ErrBinaryMalformed", err)
+	}
+}
+
+// TestBinaryCorrupted checks that crafted encodings whose fields disagree
+// with the flags or with the grammar are rejected.
+func TestBinaryCorrupted(t *testing.T) {
+	const core = FlagHasMajor | FlagHasMinor | FlagHasPatch
+	enc := func(flags Flags, major, minor, patch byte, pre, build string) []byte {
+		b := []byte{binaryVersion, binValid, byte(flags), major, minor, patch}
+		b = appendBinaryString(b, pre)
+		return appendBinaryString(b, build)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"leading zero prerelease", enc(core|FlagHasPre, 1, 2, 3, "01", "")},
+		{"prerelease with plus", enc(core|FlagHasPre, 1, 2, 3, "rc+1", "")},
+		{"empty build identifier", enc(core|FlagHasBuild, 1, 2, 3, "", "a..b")},
+		{"pre flag without prerelease", enc(core|FlagHasPre, 1, 2, 3, "", "")},
+		{"prerelease without flag", enc(core, 1, 2, 3, "rc.1", "")},
+		{"build without flag", enc(core, 1, 2, 3, "", "b")},
+		{"minor without flag", enc(FlagHasMajor, 1, 2, 0, "", "")},
+		{"patch without flag", enc(FlagHasMajor|FlagHasMinor, 1, 2, 3, "", "")},
+		{"prerelease on shorthand", enc(FlagHasMajor|FlagHasMinor|FlagHasPre, 1, 2, 0, "rc.1", "")},
+		{"no major flag", enc(FlagHasMinor|FlagHasPatch, 1, 2, 3, "", "")},
+	}
+
+	for _, tt := range tests {
+		var v Semver
+		if err := v.UnmarshalBinary(tt.data); !errors.Is(err, ErrBinaryMalformed) {
+			t.Errorf("%s: err = %v (%+v), want ErrBinaryMalformed", tt.name, err, v)
+		}
+	}
+
+	// Whatever single-byte corruption decodes must re-parse.
+	data, _ := MustParse("v1.2.3-rc.1+b.5").MarshalBinary()
+	for i := 0; i < len(data); i++ {
+		for _, c := range []byte{0, '0', '+', '.', 0x7f, 0xff} {
+			bad := append([]byte(nil), data...)
+			bad[i] = c
+
+			var v Semver
+			if v.UnmarshalBinary(bad) != nil || !v.Valid {
+				continue
+			}
+			if _, ok := ParseWith(v.Original, ParseOptions{AllowEpoch: true, AllowRevision: true}); !ok {
+				t.Errorf("byte %d = %#x decodes to %q which does not parse", i, c, v.Original)
+			}
+		}
+	}
+}
+
+// TestBinaryGob checks that gob picks up the binary encoding.
+func TestBinaryGob(t *testing.T) {
+	in := List{MustParse("v1.2.3-rc.1+b"), MustParse("2")}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("gob encode: %v", err)
+	}
+
+	var out List
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("gob decode: %v", err)
+	}
+	if len(out) != 2 || out[0] != in[0] || out[1] != in[1] {
+		t.Fatalf("gob round trip: got %v, want %v", out, in)
+	}
+}
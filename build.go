@@ -0,0 +1,124 @@
+package semver
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// BuildIdentifiers returns the dot separated build metadata identifiers,
+// e.g. ["build", "123", "sha", "abcdef"] for "+build.123.sha.abcdef".
+// Returns nil when there is no build metadata.
+func (v Semver) BuildIdentifiers() []string {
+	if v.Build == "" {
+		return nil
+	}
+
+	return strings.Split(v.Build, ".")
+}
+
+// BuildNumber returns the first purely numeric build identifier that fits
+// into int (123 for "+build.123.sha.abcdef"). Leading zeros are allowed in
+// build metadata and ignored. Returns (0, false) if there is none.
+func (v Semver) BuildNumber() (int, bool) {
+	for rest := v.Build; rest != ""; {
+		var id string
+		id, rest = nextIdent(rest)
+		if id == "" || !isNum(id) {
+			continue
+		}
+
+		if n, err := strconv.Atoi(id); err == nil {
+			return n, true
+		}
+	}
+
+	return 0, false
+}
+
+// BuildContains reports whether ident is one of the build identifiers.
+func (v Semver) BuildContains(ident string) bool {
+	for rest := v.Build; rest != ""; {
+		var id string
+		id, rest = nextIdent(rest)
+		if id == ident {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BuildCounterPrefix is the default build counter label, see WithBuildCounter.
+const BuildCounterPrefix = "build"
+
+// BuildCounter returns the CI run counter N of "+build.N" build metadata,
+// see BuildCounterFor.
+func (v Semver) BuildCounter() (int, bool) {
+	return v.BuildCounterFor(BuildCounterPrefix)
+}
+
+// BuildCounterFor returns the number following the prefix identifiers in
+// the build metadata (7 for prefix "ci.run" and "+sha.abc.ci.run.7").
+// An empty prefix means BuildCounterPrefix. Returns (0, false) if the
+// counter is absent or does not fit into int.
+func (v Semver) BuildCounterFor(prefix string) (int, bool) {
+	ids, pids := v.BuildIdentifiers(), buildCounterPrefix(prefix)
+	if i := buildCounterIndex(ids, pids); i >= 0 {
+		if n, err := strconv.Atoi(ids[i+len(pids)]); err == nil {
+			return n, true
+		}
+	}
+
+	return 0, false
+}
+
+// WithBuildCounter returns v with the build counter set to n: an existing
+// "<prefix>.N" pair is rewritten in place, otherwise "<prefix>.n" is
+// appended to the build metadata, so other identifiers survive:
+//
+//	1.2.3              -> 1.2.3+build.7
+//	1.2.3+sha.abc      -> 1.2.3+sha.abc.build.7
+//	1.2.3+build.6.sha  -> 1.2.3+build.7.sha
+//
+// An empty prefix means BuildCounterPrefix; dotted prefixes ("ci.run") are
+// allowed. Increment with v.WithBuildCounter(n+1, prefix) after reading n
+// with BuildCounterFor. Returns (zero, false) if v is invalid, n is
+// negative or prefix is not a valid build identifier sequence.
+func (v Semver) WithBuildCounter(n int, prefix string) (Semver, bool) {
+	pids := buildCounterPrefix(prefix)
+	if !v.Valid || n < 0 {
+		return Semver{Original: v.Original, Valid: false}, false
+	}
+
+	ids := v.BuildIdentifiers()
+	num := strconv.Itoa(n)
+	if i := buildCounterIndex(ids, pids); i >= 0 {
+		ids[i+len(pids)] = num
+	} else {
+		ids = append(append(ids, pids...), num)
+	}
+
+	return v.WithBuildIdentifiers(ids)
+}
+
+// buildCounterPrefix splits a build counter prefix into identifiers.
+func buildCounterPrefix(prefix string) []string {
+	if prefix == "" {
+		prefix = BuildCounterPrefix
+	}
+
+	return strings.Split(prefix, ".")
+}
+
+// buildCounterIndex returns the index of the first occurrence of pids in
+// ids that is followed by a numeric identifier, or -1.
+func buildCounterIndex(ids, pids []string) int {
+	for i := 0; i+len(pids) < len(ids); i++ {
+		if slices.Equal(ids[i:i+len(pids)], pids) && isNum(ids[i+len(pids)]) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,86 @@
+package semver
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildAccessors(t *testing.T) {
+	tests := []struct {
+		in     string
+		idents string
+		num    int
+		numOK  bool
+	}{
+		{"1.2.3+build.123.sha.abcdef", "build|123|sha|abcdef", 123, true},
+		{"1.2.3+sha.abcdef", "sha|abcdef", 0, false},
+		{"1.2.3+007.9", "007|9", 7, true},
+		{"1.2.3+99999999999999999999.5", "99999999999999999999|5", 5, true},
+		{"1.2.3", "", 0, false},
+	}
+
+	for _, tt := range tests {
+		v := MustParse(tt.in)
+		if got := strings.Join(v.BuildIdentifiers(), "|"); got != tt.idents {
+			t.Errorf("%q.BuildIdentifiers() = %q, want %q", tt.in, got, tt.idents)
+		}
+		if n, ok := v.BuildNumber(); n != tt.num || ok != tt.numOK {
+			t.Errorf("%q.BuildNumber() = %d, %v; want %d, %v", tt.in, n, ok, tt.num, tt.numOK)
+		}
+	}
+
+	v := MustParse("1.2.3+linux.amd64.ci")
+	if !v.BuildContains("amd64") || v.BuildContains("arm64") || v.BuildContains("linux.amd64") {
+		t.Errorf("BuildContains mismatch")
+	}
+	if MustParse("1.2.3").BuildIdentifiers() != nil {
+		t.Errorf("BuildIdentifiers without build must be nil")
+	}
+}
+
+func TestBuildCounter(t *testing.T) {
+	tests := []struct {
+		in     string
+		n      int
+		prefix string
+		want   string // "" if WithBuildCounter fails
+	}{
+		{"1.2.3", 7, "", "1.2.3+build.7"},
+		{"v1.2.3+sha.abc", 7, "", "v1.2.3+sha.abc.build.7"},
+		{"1.2.3+build.6.sha", 7, "build", "1.2.3+build.7.sha"},
+		{"1.2.3+build.x.build.06", 7, "", "1.2.3+build.x.build.7"},
+		{"1.2.3-rc.1+ci.run.41", 42, "ci.run", "1.2.3-rc.1+ci.run.42"},
+		{"1.2.3+build.5", 1, "run", "1.2.3+build.5.run.1"},
+		{"1.2.3", -1, "", ""},
+		{"1.2.3", 1, "bad prefix", ""},
+		{"1.2.3", 1, "a..b", ""},
+	}
+
+	for _, tt := range tests {
+		v := MustParse(tt.in)
+		got, ok := v.WithBuildCounter(tt.n, tt.prefix)
+		if tt.want == "" {
+			if ok {
+				t.Errorf("%q.WithBuildCounter(%d, %q) = %q, want failure", tt.in, tt.n, tt.prefix, got.Original)
+			}
+			continue
+		}
+		if !ok || got.Original != tt.want {
+			t.Errorf("%q.WithBuildCounter(%d, %q) = %q, %v, want %q", tt.in, tt.n, tt.prefix, got.Original, ok, tt.want)
+		}
+		if n, ok := got.BuildCounterFor(tt.prefix); !ok || n != tt.n {
+			t.Errorf("%q.BuildCounterFor(%q) = %d, %v, want %d", got.Original, tt.prefix, n, ok, tt.n)
+		}
+	}
+
+	for in, want := range map[string]int{"1.2.3+build.12": 12, "1.2.3+sha.build": -1, "1.2.3+12": -1, "1.2.3": -1} {
+		n, ok := MustParse(in).BuildCounter()
+		if ok != (want >= 0) || ok && n != want {
+			t.Errorf("%q.BuildCounter() = %d, %v, want %d", in, n, ok, want)
+		}
+	}
+
+	if _, ok := (Semver{}).WithBuildCounter(1, ""); ok {
+		t.Error("WithBuildCounter(invalid) ok")
+	}
+}
@@ -0,0 +1,34 @@
+package semver
+
+import "runtime/debug"
+
+// readBuildInfo is debug.ReadBuildInfo, replaceable in tests.
+var readBuildInfo = debug.ReadBuildInfo
+
+// FromBuildInfo returns the version of the main module of the running
+// binary as stamped by the go command (runtime/debug.ReadBuildInfo), so
+// an application can report its own version with one call.
+//
+// Binaries built with "go install mod@v1.2.3" carry that version; since
+// Go 1.24 "go build" in a VCS checkout stamps a tag version or a
+// pseudo-version such as "v0.0.0-20250101120000-abcdef123456" (with
+// "+dirty" for modified trees), which parses as a prerelease; use
+// ParsePseudo to inspect it. Returns (zero, false) for "(devel)" builds,
+// binaries without build info and unparsable versions.
+func FromBuildInfo() (Semver, bool) {
+	bi, ok := readBuildInfo()
+	if !ok || bi == nil {
+		return Semver{}, false
+	}
+
+	return fromModuleVersion(bi.Main.Version)
+}
+
+// fromModuleVersion parses a build info module version.
+func fromModuleVersion(s string) (Semver, bool) {
+	if s == "" || s == "(devel)" {
+		return Semver{Original: s, Valid: false}, false
+	}
+
+	return Parse(s)
+}
@@ -0,0 +1,40 @@
+package semver
+
+import (
+	"runtime/debug"
+	"testing"
+)
+
+func TestFromBuildInfo(t *testing.T) {
+	defer func(orig func() (*debug.BuildInfo, bool)) { readBuildInfo = orig }(readBuildInfo)
+
+	tests := []struct {
+		version string
+		ok      bool
+		want    string // Canonical
+	}{
+		{"v1.4.2", true, "v1.4.2"},
+		{"v0.0.0-20250101120000-abcdef123456", true, "v0.0.0-20250101120000-abcdef123456"},
+		{"v1.2.4-0.20250101120000-abcdef123456+dirty", true, "v1.2.4-0.20250101120000-abcdef123456"},
+		{"v2.0.0+incompatible", true, "v2.0.0"},
+		{"(devel)", false, ""},
+		{"", false, ""},
+		{"garbage", false, ""},
+	}
+
+	for _, tt := range tests {
+		readBuildInfo = func() (*debug.BuildInfo, bool) {
+			return &debug.BuildInfo{Main: debug.Module{Path: "example.com/app", Version: tt.version}}, true
+		}
+
+		v, ok := FromBuildInfo()
+		if ok != tt.ok || ok && v.Canonical() != tt.want {
+			t.Errorf("FromBuildInfo(%q) = %q, %v, want %q, %v", tt.version, v.Canonical(), ok, tt.want, tt.ok)
+		}
+	}
+
+	readBuildInfo = func() (*debug.BuildInfo, bool) { return nil, false }
+	if _, ok := FromBuildInfo(); ok {
+		t.Error("FromBuildInfo without build info ok")
+	}
+}
@@ -2,7 +2,61 @@ package semver
 
 import "strings"
 
-// BumpPatch returns v with Patch+1 and clears prerelease/build.
+// BumpKind selects which component Bump increments.
+type BumpKind uint8
+
+// Bump kinds supported by Bump and BumpIfLatest.
+const (
+	BumpKindPatch      BumpKind = iota // MAJOR.MINOR.PATCH+1, see BumpPatch
+	BumpKindMinor                      // MAJOR.MINOR+1.0, see BumpMinor
+	BumpKindMajor                      // MAJOR+1.0.0, see BumpMajor
+	BumpKindPrerelease                 // next prerelease, see Bump
+)
+
+// Bump dispatches to BumpPatch, BumpMinor, BumpMajor or NextPrerelease("")
+// depending on kind. Returns (zero, false) if v is invalid or kind is unknown.
+//
+// The result always has a higher precedence than v: BumpKindPrerelease on a
+// release starts the prerelease of the next patch ("1.2.3" -> "1.2.4-rc.1")
+// rather than one of v itself, which would sort before it.
+func (v Semver) Bump(kind BumpKind) (Semver, bool) {
+	switch kind {
+	case BumpKindPatch:
+		return v.BumpPatch()
+	case BumpKindMinor:
+		return v.BumpMinor()
+	case BumpKindMajor:
+		return v.BumpMajor()
+	case BumpKindPrerelease:
+		if v.Valid && v.Flags&FlagHasPre == 0 {
+			nv, _ := v.BumpPatch()
+			return nv.NextPrerelease("")
+		}
+		return v.NextPrerelease("")
+	default:
+		return Semver{Original: v.Original, Valid: false}, false
+	}
+}
+
+// BumpIfLatest bumps current by kind only if no version in list has a higher
+// precedence than current. It is a compare-and-swap style guard for release
+// automation: if another tag landed after current was read, the bump is
+// refused with (zero, false) instead of racing it.
+func BumpIfLatest(current Semver, list List, kind BumpKind) (Semver, bool) {
+	if !current.Valid {
+		return Semver{Original: current.Original, Valid: false}, false
+	}
+
+	for _, v := range list {
+		if v.IsGreater(current) {
+			return Semver{Original: current.Original, Valid: false}, false
+		}
+	}
+
+	return current.Bump(kind)
+}
+
+// BumpPatch returns v with Patch+1 and clears revision/prerelease/build.
 // Returns (zero, false) if v is invalid.
 func (v Semver) BumpPatch() (Semver, bool) {
 	if !v.Valid {
@@ -11,15 +65,16 @@ func (v Semver) BumpPatch() (Semver, bool) {
 
 	nv := v
 	nv.Patch++
+	nv.Revision = 0
 	nv.Prerelease, nv.Build = "", ""
 	nv.Flags |= FlagHasMajor | FlagHasMinor | FlagHasPatch
-	nv.Flags &^= (FlagHasPre | FlagHasBuild)
+	nv.Flags &^= (FlagHasRevision | FlagHasPre | FlagHasBuild)
 	nv.Original = nv.Print(PrintMaskDefault)
 
 	return nv, true
 }
 
-// BumpMinor returns v with Minor+1, Patch=0 and clears prerelease/build.
+// BumpMinor returns v with Minor+1, Patch=0 and clears revision/prerelease/build.
 func (v Semver) BumpMinor() (Semver, bool) {
 	if !v.Valid {
 		return Semver{Original: v.Original, Valid: false}, false
@@ -28,15 +83,16 @@ func (v Semver) BumpMinor() (Semver, bool) {
 	nv := v
 	nv.Minor++
 	nv.Patch = 0
+	nv.Revision = 0
 	nv.Prerelease, nv.Build = "", ""
 	nv.Flags |= FlagHasMajor | FlagHasMinor | FlagHasPatch
-	nv.Flags &^= (FlagHasPre | FlagHasBuild)
+	nv.Flags &^= (FlagHasRevision | FlagHasPre | FlagHasBuild)
 	nv.Original = nv.Print(PrintMaskDefault)
 
 	return nv, true
 }
 
-// BumpMajor returns v with Major+1, Minor=0, Patch=0 and clears prerelease/build.
+// BumpMajor returns v with Major+1, Minor=0, Patch=0 and clears revision/prerelease/build.
 func (v Semver) BumpMajor() (Semver, bool) {
 	if !v.Valid {
 		return Semver{Original: v.Original, Valid: false}, false
@@ -45,9 +101,58 @@ func (v Semver) BumpMajor() (Semver, bool) {
 	nv := v
 	nv.Major++
 	nv.Minor, nv.Patch = 0, 0
+	nv.Revision = 0
 	nv.Prerelease, nv.Build = "", ""
 	nv.Flags |= FlagHasMajor | FlagHasMinor | FlagHasPatch
-	nv.Flags &^= (FlagHasPre | FlagHasBuild)
+	nv.Flags &^= (FlagHasRevision | FlagHasPre | FlagHasBuild)
+	nv.Original = nv.Print(PrintMaskDefault)
+
+	return nv, true
+}
+
+// WithMajor returns v with MAJOR set to n; all other parts are kept and a
+// shorthand is expanded to MAJOR.MINOR.PATCH.
+// Returns (zero, false) if v is invalid or n is negative.
+func (v Semver) WithMajor(n int) (Semver, bool) {
+	if !v.Valid || n < 0 {
+		return Semver{Original: v.Original, Valid: false}, false
+	}
+
+	nv := v
+	nv.Major = n
+	nv.Flags |= FlagHasMinor | FlagHasPatch
+	nv.Original = nv.Print(PrintMaskDefault)
+
+	return nv, true
+}
+
+// WithMinor returns v with MINOR set to n; all other parts are kept and a
+// shorthand is expanded to MAJOR.MINOR.PATCH.
+// Returns (zero, false) if v is invalid or n is negative.
+func (v Semver) WithMinor(n int) (Semver, bool) {
+	if !v.Valid || n < 0 {
+		return Semver{Original: v.Original, Valid: false}, false
+	}
+
+	nv := v
+	nv.Minor = n
+	nv.Flags |= FlagHasMinor | FlagHasPatch
+	nv.Original = nv.Print(PrintMaskDefault)
+
+	return nv, true
+}
+
+// WithPatch returns v with PATCH set to n; all other parts are kept and a
+// shorthand is expanded to MAJOR.MINOR.PATCH.
+// Returns (zero, false) if v is invalid or n is negative.
+func (v Semver) WithPatch(n int) (Semver, bool) {
+	if !v.Valid || n < 0 {
+		return Semver{Original: v.Original, Valid: false}, false
+	}
+
+	nv := v
+	nv.Patch = n
+	nv.Flags |= FlagHasMinor | FlagHasPatch
 	nv.Original = nv.Print(PrintMaskDefault)
 
 	return nv, true
@@ -129,6 +234,65 @@ func (v Semver) WithBuild(build string) (Semver, bool) {
 	return nv, true
 }
 
+// WithPrereleaseIdentifiers is WithPre taking the identifiers as a slice,
+// e.g. []string{"rc", "1"}. Each identifier is validated on its own, so a
+// '.' inside one is rejected. An empty slice removes the prerelease.
+func (v Semver) WithPrereleaseIdentifiers(ids []string) (Semver, bool) {
+	for _, id := range ids {
+		if !isPreIdent(id) {
+			return Semver{Original: v.Original, Valid: false}, false
+		}
+	}
+
+	return v.WithPre(strings.Join(ids, "."))
+}
+
+// WithBuildIdentifiers is WithBuild taking the identifiers as a slice,
+// e.g. []string{"sha", "abc123"}. An empty slice removes the build metadata.
+func (v Semver) WithBuildIdentifiers(ids []string) (Semver, bool) {
+	for _, id := range ids {
+		if !isBuildIdent(id) {
+			return Semver{Original: v.Original, Valid: false}, false
+		}
+	}
+
+	return v.WithBuild(strings.Join(ids, "."))
+}
+
+// AppendPre appends one identifier to the prerelease ("rc" + "1" -> "rc.1").
+func (v Semver) AppendPre(ident string) (Semver, bool) {
+	if !isPreIdent(ident) {
+		return Semver{Original: v.Original, Valid: false}, false
+	}
+	if v.Prerelease == "" {
+		return v.WithPre(ident)
+	}
+
+	return v.WithPre(v.Prerelease + "." + ident)
+}
+
+// AppendBuild appends one identifier to the build metadata.
+func (v Semver) AppendBuild(ident string) (Semver, bool) {
+	if !isBuildIdent(ident) {
+		return Semver{Original: v.Original, Valid: false}, false
+	}
+	if v.Build == "" {
+		return v.WithBuild(ident)
+	}
+
+	return v.WithBuild(v.Build + "." + ident)
+}
+
+// isPreIdent reports whether id is a single valid prerelease identifier.
+func isPreIdent(id string) bool {
+	return isBuildIdent(id) && !isBadNum(id)
+}
+
+// isBuildIdent reports whether id is a single valid build identifier.
+func isBuildIdent(id string) bool {
+	return id != "" && badByte(id, isIdentChar) < 0
+}
+
 // StripPre removes prerelease if present.
 func (v Semver) StripPre() (Semver, bool) {
 	if !v.Valid {
@@ -160,6 +324,8 @@ func (v Semver) StripBuild() (Semver, bool) {
 // NextPrerelease increments the last numeric identifier.
 // If none, appends ".1". If prerelease empty, sets to base (e.g. "rc.1").
 // base is used only when current prerelease is empty; pass "" to default "rc".
+// On a release the result is a prerelease of the same version and so sorts
+// before v; Bump with BumpKindPrerelease moves to the next patch first.
 func (v Semver) NextPrerelease(base string) (Semver, bool) {
 	if !v.Valid {
 		return Semver{Original: v.Original, Valid: false}, false
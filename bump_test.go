@@ -238,3 +238,99 @@ func TestNextPrerelease(t *testing.T) {
 		t.Errorf("NextPrerelease default base: got %q, ok=%v; want v1.2.3-rc.1, true", vn.Canonical(), ok)
 	}
 }
+
+func TestBumpIfLatest(t *testing.T) {
+	cur, _ := Parse("1.2.3")
+	list := mustList("1.0.0", "1.2.3", "1.2.3+build", "bad")
+
+	nv, ok := BumpIfLatest(cur, list, BumpKindMinor)
+	if !ok || nv.Canonical() != "v1.3.0" {
+		t.Fatalf("BumpIfLatest: got %q ok=%v, want v1.3.0", nv.Canonical(), ok)
+	}
+
+	// someone else released 1.2.4 in the meantime
+	list = append(list, mustList("1.2.4")...)
+	if _, ok := BumpIfLatest(cur, list, BumpKindPatch); ok {
+		t.Fatalf("BumpIfLatest: bumped although list has a newer version")
+	}
+
+	if _, ok := cur.Bump(BumpKind(99)); ok {
+		t.Fatalf("Bump: accepted unknown kind")
+	}
+	if nv, ok := cur.Bump(BumpKindPrerelease); !ok || nv.Canonical() != "v1.2.4-rc.1" || !nv.IsGreater(cur) {
+		t.Fatalf("Bump(prerelease) of a release: got %q", nv.Canonical())
+	}
+	if nv, ok := MustParse("v1.2.4-rc.1").Bump(BumpKindPrerelease); !ok || nv.Original != "v1.2.4-rc.2" {
+		t.Fatalf("Bump(prerelease) of a prerelease: got %q", nv.Original)
+	}
+}
+
+func TestIdentifierBuilders(t *testing.T) {
+	v := MustParse("v1.2")
+
+	got, ok := v.WithPrereleaseIdentifiers([]string{"rc", "1"})
+	if !ok || got.Original != "v1.2.0-rc.1" {
+		t.Fatalf("WithPrereleaseIdentifiers = %q, %v", got.Original, ok)
+	}
+	got, ok = got.WithBuildIdentifiers([]string{"sha", "0abc"})
+	if !ok || got.Original != "v1.2.0-rc.1+sha.0abc" {
+		t.Fatalf("WithBuildIdentifiers = %q, %v", got.Original, ok)
+	}
+	got, ok = got.AppendPre("x-1")
+	if !ok || got.Prerelease != "rc.1.x-1" {
+		t.Fatalf("AppendPre = %q, %v", got.Prerelease, ok)
+	}
+	got, ok = got.AppendBuild("007")
+	if !ok || got.Build != "sha.0abc.007" {
+		t.Fatalf("AppendBuild = %q, %v", got.Build, ok)
+	}
+	if got, ok := got.WithPrereleaseIdentifiers(nil); !ok || got.HasPre() {
+		t.Fatalf("WithPrereleaseIdentifiers(nil) = %q, %v", got.Original, ok)
+	}
+	if got, ok := MustParse("1.0.0").AppendPre("alpha"); !ok || got.Original != "1.0.0-alpha" {
+		t.Fatalf("AppendPre on release = %q, %v", got.Original, ok)
+	}
+
+	for name, fn := range map[string]func() (Semver, bool){
+		"pre dot":       func() (Semver, bool) { return v.WithPrereleaseIdentifiers([]string{"rc.1"}) },
+		"pre empty":     func() (Semver, bool) { return v.WithPrereleaseIdentifiers([]string{"rc", ""}) },
+		"pre zero":      func() (Semver, bool) { return v.AppendPre("01") },
+		"build char":    func() (Semver, bool) { return v.WithBuildIdentifiers([]string{"a_b"}) },
+		"build empty":   func() (Semver, bool) { return v.AppendBuild("") },
+		"invalid input": func() (Semver, bool) { return Semver{}.AppendPre("rc") },
+	} {
+		if _, ok := fn(); ok {
+			t.Errorf("%s: accepted invalid input", name)
+		}
+	}
+}
+
+func TestWithComponents(t *testing.T) {
+	tests := []struct {
+		in   string
+		fn   func(Semver) (Semver, bool)
+		want string
+	}{
+		{"v1.2.3-rc.1+b", func(v Semver) (Semver, bool) { return v.WithMajor(4) }, "v4.2.3-rc.1+b"},
+		{"1.2.3", func(v Semver) (Semver, bool) { return v.WithMinor(0) }, "1.0.3"},
+		{"2", func(v Semver) (Semver, bool) { return v.WithMajor(3) }, "3.0.0"},
+		{"1", func(v Semver) (Semver, bool) { return v.WithMinor(5) }, "1.5.0"},
+		{"V1", func(v Semver) (Semver, bool) { return v.WithPatch(7) }, "V1.0.7"},
+		{"1.2.3", func(v Semver) (Semver, bool) { return v.WithPatch(-1) }, ""},
+		{"bad", func(v Semver) (Semver, bool) { return v.WithMajor(1) }, ""},
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.in)
+		got, ok := tt.fn(v)
+		if ok != (tt.want != "") || (ok && got.Original != tt.want) {
+			t.Errorf("%q: got %q, %v; want %q", tt.in, got.Original, ok, tt.want)
+			continue
+		}
+
+		// Original and Flags stay consistent with a fresh parse
+		if ok && got != MustParse(got.Original) {
+			t.Errorf("%q: %+v differs from Parse(%q)", tt.in, got, got.Original)
+		}
+	}
+}
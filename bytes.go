@@ -0,0 +1,18 @@
+package semver
+
+import "unsafe"
+
+// ParseBytes is like Parse for input held in a byte buffer. The result
+// owns a single copy of b (Original, with Prerelease and Build slicing it),
+// so b may be reused after the call.
+func ParseBytes(b []byte) (Semver, bool) {
+	return Parse(string(b))
+}
+
+// ParseBytesUnsafe is like ParseBytes but does not copy: Original,
+// Prerelease and Build alias b. The caller guarantees b is not modified
+// for as long as the result (or any string taken from it) is in use;
+// call Compact to detach the result once b must be reused.
+func ParseBytesUnsafe(b []byte) (Semver, bool) {
+	return Parse(unsafe.String(unsafe.SliceData(b), len(b)))
+}
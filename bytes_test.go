@@ -0,0 +1,68 @@
+package semver
+
+import "testing"
+
+func TestParseBytes(t *testing.T) {
+	for _, s := range []string{"v1.2.3-rc.1+b.5", "1.2", "bad", ""} {
+		want, wantOK := Parse(s)
+
+		buf := []byte(s)
+		v, ok := ParseBytes(buf)
+		u, uok := ParseBytesUnsafe(buf)
+		if ok != wantOK || uok != wantOK || v != want || u != want {
+			t.Errorf("ParseBytes(%q) = %+v, %v; unsafe %+v, %v; want %+v, %v", s, v, ok, u, uok, want, wantOK)
+		}
+
+		// ParseBytes owns its copy, the unsafe variant aliases buf
+		for i := range buf {
+			buf[i] = 'x'
+		}
+		if v.Original != s {
+			t.Errorf("ParseBytes(%q).Original changed with the buffer: %q", s, v.Original)
+		}
+		if len(s) > 0 && u.Original == s {
+			t.Errorf("ParseBytesUnsafe(%q).Original does not alias the buffer", s)
+		}
+	}
+
+	buf := []byte("v1.2.3-rc.1+b.5")
+	if n := testing.AllocsPerRun(100, func() { _, _ = ParseBytesUnsafe(buf) }); n != 0 {
+		t.Errorf("ParseBytesUnsafe allocs = %v, want 0", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { _, _ = ParseBytes(buf) }); n > 1 {
+		t.Errorf("ParseBytes allocs = %v, want <= 1", n)
+	}
+}
+
+func BenchmarkParseBytes(b *testing.B) {
+	bufs := make([][]byte, len(benchInputs))
+	for i, s := range benchInputs {
+		bufs[i] = []byte(s)
+	}
+
+	b.Run("copy", func(b *testing.B) {
+		b.ReportAllocs()
+		n := 0
+		for i := 0; i < b.N; i++ {
+			for _, buf := range bufs {
+				if v, ok := ParseBytes(buf); ok {
+					n += v.Major
+				}
+			}
+		}
+		sinkInt = n
+	})
+
+	b.Run("unsafe", func(b *testing.B) {
+		b.ReportAllocs()
+		n := 0
+		for i := 0; i < b.N; i++ {
+			for _, buf := range bufs {
+				if v, ok := ParseBytesUnsafe(buf); ok {
+					n += v.Major
+				}
+			}
+		}
+		sinkInt = n
+	})
+}
@@ -0,0 +1,69 @@
+package semver
+
+import "sync"
+
+// DefaultCacheSize is the capacity of the package-level cache used by InternParse.
+const DefaultCacheSize = 4096
+
+// Cache memoizes Parse results keyed by the input string.
+// It is safe for concurrent use. Once the cache holds max entries, further
+// inputs are parsed but not stored, so a flood of unique strings can not
+// grow it without bound. The zero value is an unbounded cache.
+type Cache struct {
+	m    map[string]Semver
+	size int
+	mu   sync.RWMutex
+}
+
+// NewCache returns a Cache holding at most size entries (size <= 0 means unbounded).
+func NewCache(size int) *Cache {
+	return &Cache{m: make(map[string]Semver), size: size}
+}
+
+// Parse returns the memoized result of Parse(s), parsing and storing it on
+// first use. Invalid inputs are memoized too.
+func (c *Cache) Parse(s string) (Semver, bool) {
+	c.mu.RLock()
+	v, hit := c.m[s]
+	c.mu.RUnlock()
+	if hit {
+		return v, v.Valid
+	}
+
+	v, ok := Parse(s)
+
+	c.mu.Lock()
+	if c.m == nil {
+		c.m = make(map[string]Semver)
+	}
+	if c.size <= 0 || len(c.m) < c.size {
+		c.m[s] = v
+	}
+	c.mu.Unlock()
+
+	return v, ok
+}
+
+// Len returns the number of memoized inputs.
+func (c *Cache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.m)
+}
+
+// Reset drops all memoized inputs.
+func (c *Cache) Reset() {
+	c.mu.Lock()
+	c.m = make(map[string]Semver)
+	c.mu.Unlock()
+}
+
+// defaultCache backs InternParse.
+var defaultCache = NewCache(DefaultCacheSize)
+
+// InternParse is Parse memoized in a package-level Cache of DefaultCacheSize
+// entries. Use it for hot paths that parse the same few strings repeatedly.
+func InternParse(s string) (Semver, bool) {
+	return defaultCache.Parse(s)
+}
@@ -0,0 +1,57 @@
+package semver
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestCache checks memoization, bounds and concurrent use.
+func TestCache(t *testing.T) {
+	c := NewCache(2)
+
+	v, ok := c.Parse("v1.2.3-rc.1")
+	if !ok || v.Canonical() != "v1.2.3-rc.1" {
+		t.Fatalf("Cache.Parse: got %q ok=%v", v.Canonical(), ok)
+	}
+	if _, ok := c.Parse("bad"); ok {
+		t.Fatalf("Cache.Parse accepted invalid input")
+	}
+	if _, ok := c.Parse("bad"); ok {
+		t.Fatalf("Cache.Parse accepted memoized invalid input")
+	}
+
+	c.Parse("1.0.0")
+	if c.Len() != 2 {
+		t.Fatalf("Cache.Len() = %d, want 2 (bounded)", c.Len())
+	}
+
+	c.Reset()
+	if c.Len() != 0 {
+		t.Fatalf("Cache.Len() after Reset = %d, want 0", c.Len())
+	}
+
+	var zero Cache
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, s := range benchInputs {
+				zero.Parse(s)
+			}
+		}()
+	}
+	wg.Wait()
+	if zero.Len() != len(benchInputs) {
+		t.Fatalf("zero Cache.Len() = %d, want %d", zero.Len(), len(benchInputs))
+	}
+}
+
+// BenchmarkInternParse benchmarks memoized parsing of a small input set.
+func BenchmarkInternParse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		v, _ := InternParse(benchInputs[i%len(benchInputs)])
+		sinkInt += v.Major
+	}
+}
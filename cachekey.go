@@ -0,0 +1,77 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CacheKeySep separates parts in keys produced by CacheKey and CacheKeyWithBuild.
+const CacheKeySep = ':'
+
+// CacheKey joins parts into a single cache key.
+// Semver values (and pointers to them) are rendered in canonical form, so
+// "v1.2.3", "1.2.3" and "1.2.3+ci" all produce the same key part. Invalid
+// versions fall back to Original. Other values are rendered with fmt.Sprint.
+// Every part is escaped so that CacheKeySep inside a part cannot collide
+// with the separator itself.
+func CacheKey(parts ...any) string {
+	return cacheKey(PrintMaskCanonical, parts)
+}
+
+// CacheKeyWithBuild is like CacheKey but keeps build metadata of Semver parts,
+// so "v1.2.3+ci" and "v1.2.3" produce different keys.
+func CacheKeyWithBuild(parts ...any) string {
+	return cacheKey(PrintMaskCanonical|PrintBuild, parts)
+}
+
+// cacheKey renders parts with mask for Semver values and joins them escaped.
+func cacheKey(mask PrintFlags, parts []any) string {
+	var b strings.Builder
+	for i, p := range parts {
+		if i > 0 {
+			b.WriteByte(CacheKeySep)
+		}
+
+		var s string
+		switch x := p.(type) {
+		case Semver:
+			s = cacheKeyPart(&x, mask)
+		case *Semver:
+			if x != nil {
+				s = cacheKeyPart(x, mask)
+			}
+		case string:
+			s = x
+		default:
+			s = fmt.Sprint(x)
+		}
+
+		writeCacheKeyEscaped(&b, s)
+	}
+
+	return b.String()
+}
+
+// cacheKeyPart renders v with mask, falling back to Original for invalid versions.
+func cacheKeyPart(v *Semver, mask PrintFlags) string {
+	if !v.Valid {
+		return v.Original
+	}
+
+	return v.Print(mask)
+}
+
+// writeCacheKeyEscaped writes s escaping '%' and CacheKeySep as %XX.
+func writeCacheKeyEscaped(b *strings.Builder, s string) {
+	const hex = "0123456789ABCDEF"
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '%' || c == CacheKeySep {
+			b.WriteByte('%')
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&0x0f])
+			continue
+		}
+		b.WriteByte(c)
+	}
+}
@@ -0,0 +1,23 @@
+package semver
+
+import "testing"
+
+// TestCacheKey checks canonical rendering, escaping and build handling.
+func TestCacheKey(t *testing.T) {
+	a, _ := Parse("v1.2.3")
+	b, _ := Parse("1.2.3+ci")
+	bad, _ := Parse("1.x")
+
+	if ka, kb := CacheKey("app", a), CacheKey("app", &b); ka != kb || ka != "app:v1.2.3" {
+		t.Errorf("CacheKey: got %q and %q, want both app:v1.2.3", ka, kb)
+	}
+	if got := CacheKeyWithBuild("app", b); got != "app:v1.2.3+ci" {
+		t.Errorf("CacheKeyWithBuild = %q", got)
+	}
+	if got := CacheKey("a:b", "100%", 7, bad); got != "a%3Ab:100%25:7:1.x" {
+		t.Errorf("CacheKey escaping = %q", got)
+	}
+	if CacheKey("a:b", "c") == CacheKey("a", "b:c") {
+		t.Errorf("CacheKey: separator collision")
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Package calver parses calendar versions (https://calver.org) such as
+"2024.06.15" (YYYY.0M.0D) or "24.04.1" (YY.0M.MICRO) and maps them into
+semver.Semver values, so CalVer and SemVer tags can be compared and sorted
+in the same semver.List.
+
+A scheme is a dot-separated list of segment tokens:
+
+	YYYY  full year          2006, 2016, 2106
+	YY    short year         6, 16, 106 (year - 2000)
+	0Y    zero-padded year   06, 16, 106
+	MM    month              1 ... 12
+	0M    zero-padded month  01 ... 12
+	WW    week               1 ... 53
+	0W    zero-padded week   01 ... 53
+	DD    day                1 ... 31
+	0D    zero-padded day    01 ... 31
+	MAJOR, MINOR, MICRO      plain counters
+
+At most three segments are supported, they map onto MAJOR.MINOR.PATCH.
+An optional "-MODIFIER" suffix maps onto the semver prerelease.
+*/
+package calver
+
+import (
+	"strings"
+
+	"github.com/woozymasta/semver"
+)
+
+// Version is a parsed calendar version.
+type Version struct {
+	// Original the raw input string
+	Original string
+
+	// Scheme the scheme the version was parsed with
+	Scheme string
+
+	// Modifier optional suffix after '-' (e.g. "rc1"), empty if absent
+	Modifier string
+
+	// Segments numeric segment values in scheme order (years are not expanded)
+	Segments []int
+
+	// Year full year (e.g. 2024 for "24" in YY), 0 if the scheme has no year
+	Year int
+
+	// Month 1..12, 0 if the scheme has no month
+	Month int
+
+	// Week 1..53, 0 if the scheme has no week
+	Week int
+
+	// Day 1..31, 0 if the scheme has no day
+	Day int
+}
+
+// Parse parses s according to scheme. Returns (zero, false) if the scheme
+// is unknown or s does not match it.
+func Parse(scheme, s string) (Version, bool) {
+	tokens := strings.Split(scheme, ".")
+	if len(tokens) > 3 {
+		return Version{}, false
+	}
+
+	core, mod := s, ""
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		core, mod = s[:i], s[i+1:]
+		if mod == "" {
+			return Version{}, false
+		}
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) != len(tokens) {
+		return Version{}, false
+	}
+
+	v := Version{Original: s, Scheme: scheme, Modifier: mod, Segments: make([]int, len(parts))}
+	for i, tok := range tokens {
+		n, ok := parseSegment(tok, parts[i])
+		if !ok {
+			return Version{}, false
+		}
+		v.Segments[i] = n
+
+		switch tok {
+		case "YYYY":
+			v.Year = n
+		case "YY", "0Y":
+			v.Year = 2000 + n
+		case "MM", "0M":
+			v.Month = n
+		case "WW", "0W":
+			v.Week = n
+		case "DD", "0D":
+			v.Day = n
+		}
+	}
+
+	// the modifier must be representable as a semver prerelease
+	if _, ok := v.Semver(); !ok {
+		return Version{}, false
+	}
+
+	return v, true
+}
+
+// Semver maps v onto a semver.Semver: segments become MAJOR.MINOR.PATCH
+// (missing ones are zero) and the modifier becomes the prerelease.
+// Ordering of the result matches Compare for versions of the same scheme.
+func (v Version) Semver() (semver.Semver, bool) {
+	var b strings.Builder
+	for i := 0; i < 3; i++ {
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		n := 0
+		if i < len(v.Segments) {
+			n = v.Segments[i]
+		}
+		b.WriteString(itoa(n))
+	}
+	if v.Modifier != "" {
+		b.WriteByte('-')
+		b.WriteString(v.Modifier)
+	}
+
+	return semver.Parse(b.String())
+}
+
+// Compare compares v with w segment by segment; a version without modifier
+// is greater than the same version with one (like a semver prerelease).
+// Returns -1, 0 or +1.
+func (v Version) Compare(w Version) int {
+	a, _ := v.Semver()
+	b, _ := w.Semver()
+
+	return a.Compare(b)
+}
+
+// parseSegment parses part according to a single scheme token.
+func parseSegment(tok, part string) (int, bool) {
+	if part == "" || !isDigits(part) {
+		return 0, false
+	}
+
+	padded := len(part) > 1 && part[0] == '0'
+	n := atoi(part)
+
+	switch tok {
+	case "YYYY":
+		return n, len(part) == 4 && !padded
+	case "YY":
+		return n, !padded
+	case "0Y":
+		return n, len(part) >= 2 && (len(part) == 2 || !padded)
+	case "MM":
+		return n, !padded && n >= 1 && n <= 12
+	case "0M":
+		return n, len(part) == 2 && n >= 1 && n <= 12
+	case "WW":
+		return n, !padded && n >= 1 && n <= 53
+	case "0W":
+		return n, len(part) == 2 && n >= 1 && n <= 53
+	case "DD":
+		return n, !padded && n >= 1 && n <= 31
+	case "0D":
+		return n, len(part) == 2 && n >= 1 && n <= 31
+	case "MAJOR", "MINOR", "MICRO":
+		return n, !padded
+	}
+
+	return 0, false
+}
+
+// isDigits reports whether s consists of 1..9 ASCII digits (fits any int).
+func isDigits(s string) bool {
+	if len(s) > 9 {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// atoi converts a digit-only string to int.
+func atoi(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = n*10 + int(s[i]-'0')
+	}
+
+	return n
+}
+
+// itoa converts a non-negative int to its decimal representation.
+func itoa(n int) string {
+	if n == 0 {
+		return "0"
+	}
+
+	var buf [20]byte
+	i := len(buf)
+	for ; n > 0; n /= 10 {
+		i--
+		buf[i] = byte('0' + n%10)
+	}
+
+	return string(buf[i:])
+}
@@ -0,0 +1,70 @@
+package calver
+
+import (
+	"testing"
+
+	"github.com/woozymasta/semver"
+)
+
+// TestParse checks scheme validation and field extraction.
+func TestParse(t *testing.T) {
+	tests := []struct {
+		scheme, in string
+		ok         bool
+		year       int
+		canon      string
+	}{
+		{"YYYY.0M.0D", "2024.06.15", true, 2024, "v2024.6.15"},
+		{"YY.0M.MICRO", "24.04.1", true, 2024, "v24.4.1"},
+		{"YY.0M", "24.10-rc1", true, 2024, "v24.10.0-rc1"},
+		{"YYYY.MM", "2024.6", true, 2024, "v2024.6.0"},
+		{"YYYY.WW.MICRO", "2024.53.0", true, 2024, "v2024.53.0"},
+
+		{"YYYY.0M.0D", "2024.6.15", false, 0, ""},
+		{"YYYY.MM", "2024.06", false, 0, ""},
+		{"YYYY.0M", "2024.13", false, 0, ""},
+		{"YYYY.0M", "24.01", false, 0, ""},
+		{"YYYY.0M.0D", "2024.06", false, 0, ""},
+		{"YYYY.0M", "2024.06-", false, 0, ""},
+		{"YYYY.0M", "2024.06-bad..mod", false, 0, ""},
+		{"YYYY.QQ", "2024.1", false, 0, ""},
+	}
+
+	for _, tt := range tests {
+		v, ok := Parse(tt.scheme, tt.in)
+		if ok != tt.ok {
+			t.Errorf("Parse(%q, %q) ok=%v, want %v", tt.scheme, tt.in, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		sv, _ := v.Semver()
+		if v.Year != tt.year || sv.Canonical() != tt.canon {
+			t.Errorf("Parse(%q, %q) = year %d %q, want year %d %q",
+				tt.scheme, tt.in, v.Year, sv.Canonical(), tt.year, tt.canon)
+		}
+	}
+}
+
+// TestCompareAndMixedSort checks ordering and sorting together with semver tags.
+func TestCompareAndMixedSort(t *testing.T) {
+	a, _ := Parse("YY.0M.MICRO", "24.04.1")
+	b, _ := Parse("YY.0M.MICRO", "24.10.0")
+	rc, _ := Parse("YY.0M.MICRO", "24.10.0-rc1")
+
+	if a.Compare(b) != -1 || b.Compare(rc) != 1 || rc.Compare(rc) != 0 {
+		t.Fatalf("Compare: unexpected order")
+	}
+
+	sa, _ := a.Semver()
+	sb, _ := b.Semver()
+	other, _ := semver.Parse("v24.5.0")
+
+	ls := semver.List{sb, other, sa}
+	ls.Sort()
+	if ls[0].Canonical() != "v24.4.1" || ls[1].Canonical() != "v24.5.0" || ls[2].Canonical() != "v24.10.0" {
+		t.Fatalf("mixed sort: got %v", ls)
+	}
+}
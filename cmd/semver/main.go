@@ -0,0 +1,365 @@
+/*
+Command semver exposes the github.com/woozymasta/semver library to shell
+pipelines.
+
+Usage:
+
+	semver parse   [-json] [VERSION...]
+	semver compare [-json] A B
+	semver sort    [-json] [-r] [VERSION...]
+	semver bump    [-json] major|minor|patch|pre VERSION
+	semver check   [-json] CONSTRAINT [VERSION...]
+	semver latest  [-json] [-c CONSTRAINT] [VERSION...]
+
+Commands that accept a list of versions read them from stdin, one per line,
+when no arguments are given. Invalid versions are reported on stderr and
+skipped by sort, check and latest. With -json the parsed structure is
+printed as JSON instead of the canonical form. Bumping a release with "pre"
+starts the prerelease of the next patch ("1.2.3" -> "1.2.4-rc.1"), so the
+result is always higher than the input. Without -c, latest prints the
+highest release, or the highest prerelease when there is no release.
+
+Exit status is 0 on success, 1 when a version is invalid or nothing
+matched, 2 on usage errors.
+*/
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/woozymasta/semver"
+)
+
+// exit codes
+const (
+	exitOK    = 0
+	exitFail  = 1
+	exitUsage = 2
+)
+
+// usage is printed on usage errors.
+const usage = `usage:
+  semver parse   [-json] [VERSION...]
+  semver compare [-json] A B
+  semver sort    [-json] [-r] [VERSION...]
+  semver bump    [-json] major|minor|patch|pre VERSION
+  semver check   [-json] CONSTRAINT [VERSION...]
+  semver latest  [-json] [-c CONSTRAINT] [VERSION...]
+`
+
+// jsonVersion is the JSON form of a parsed version.
+type jsonVersion struct {
+	Original   string `json:"original"`
+	Canonical  string `json:"canonical,omitempty"`
+	Prerelease string `json:"prerelease,omitempty"`
+	Build      string `json:"build,omitempty"`
+	Major      int    `json:"major"`
+	Minor      int    `json:"minor"`
+	Patch      int    `json:"patch"`
+	Valid      bool   `json:"valid"`
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// cli holds the streams and common options of a command invocation.
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	json   bool
+}
+
+// run executes the command line and returns the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitUsage
+	}
+
+	c := &cli{stdin: stdin, stdout: stdout, stderr: stderr}
+	fs := flag.NewFlagSet("semver "+args[0], flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.BoolVar(&c.json, "json", false, "print parsed structure as JSON")
+
+	// command specific flags, so other commands reject them
+	var reverse bool
+	var constraint string
+	switch args[0] {
+	case "sort":
+		fs.BoolVar(&reverse, "r", false, "sort in descending order")
+	case "latest":
+		fs.StringVar(&constraint, "c", "", "only consider versions satisfying `CONSTRAINT`")
+	case "parse", "compare", "bump", "check":
+	default:
+		fmt.Fprintf(stderr, "semver: unknown command %q\n%s", args[0], usage)
+		return exitUsage
+	}
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return exitUsage
+	}
+	rest := fs.Args()
+
+	switch args[0] {
+	case "parse":
+		return c.parse(rest)
+	case "compare":
+		return c.compare(rest)
+	case "sort":
+		return c.sort(rest, reverse)
+	case "bump":
+		return c.bump(rest)
+	case "check":
+		return c.check(rest)
+	}
+
+	return c.latest(rest, constraint)
+}
+
+// parse prints each version in canonical or JSON form.
+func (c *cli) parse(args []string) int {
+	status := exitOK
+	for _, s := range c.inputs(args) {
+		v, ok := semver.Parse(s)
+		if !ok {
+			status = exitFail
+			if !c.json {
+				c.invalid(s)
+				continue
+			}
+		}
+		c.print(v)
+	}
+
+	return status
+}
+
+// invalid reports an invalid version with the closest suggested fix.
+func (c *cli) invalid(s string) {
+	if hints := semver.Suggest(s); len(hints) > 0 {
+		fmt.Fprintf(c.stderr, "semver: invalid version %q, did you mean %q?\n", s, hints[0])
+		return
+	}
+
+	fmt.Fprintf(c.stderr, "semver: invalid version %q\n", s)
+}
+
+// compare prints -1, 0 or 1 for two versions.
+func (c *cli) compare(args []string) int {
+	if len(args) != 2 {
+		fmt.Fprint(c.stderr, usage)
+		return exitUsage
+	}
+
+	a, okA := semver.Parse(args[0])
+	b, okB := semver.Parse(args[1])
+	if !okA || !okB {
+		fmt.Fprintf(c.stderr, "semver: invalid version in %q %q\n", args[0], args[1])
+		return exitFail
+	}
+
+	if c.json {
+		return c.encode(a.Compare(b))
+	}
+
+	fmt.Fprintln(c.stdout, a.Compare(b))
+	return exitOK
+}
+
+// sort prints valid versions in semver order.
+func (c *cli) sort(args []string, reverse bool) int {
+	ls := c.list(args)
+	ls.Sort()
+	if reverse {
+		for i, j := 0, len(ls)-1; i < j; i, j = i+1, j-1 {
+			ls[i], ls[j] = ls[j], ls[i]
+		}
+	}
+
+	c.printList(ls)
+	return exitOK
+}
+
+// bump prints the bumped version. "pre" on a release starts the prerelease
+// of the next patch, see semver.Semver.Bump.
+func (c *cli) bump(args []string) int {
+	if len(args) != 2 {
+		fmt.Fprint(c.stderr, usage)
+		return exitUsage
+	}
+
+	kinds := map[string]semver.BumpKind{
+		"major": semver.BumpKindMajor,
+		"minor": semver.BumpKindMinor,
+		"patch": semver.BumpKindPatch,
+		"pre":   semver.BumpKindPrerelease,
+	}
+	kind, ok := kinds[args[0]]
+	if !ok {
+		fmt.Fprintf(c.stderr, "semver: unknown bump kind %q\n", args[0])
+		return exitUsage
+	}
+
+	v, ok := semver.Parse(args[1])
+	if !ok {
+		c.invalid(args[1])
+		return exitFail
+	}
+
+	nv, _ := v.Bump(kind)
+	c.print(nv)
+	return exitOK
+}
+
+// check prints versions satisfying a constraint.
+func (c *cli) check(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(c.stderr, usage)
+		return exitUsage
+	}
+
+	cons, ok := semver.ParseConstraint(args[0])
+	if !ok {
+		fmt.Fprintf(c.stderr, "semver: invalid constraint %q\n", args[0])
+		return exitUsage
+	}
+
+	var match semver.List
+	for _, v := range c.list(args[1:]) {
+		if cons.Check(v) {
+			match = append(match, v)
+		}
+	}
+
+	c.printList(match)
+	if len(match) == 0 {
+		return exitFail
+	}
+
+	return exitOK
+}
+
+// latest prints the highest valid version, optionally constrained.
+// Without a constraint, prereleases are only considered when the input
+// has no release.
+func (c *cli) latest(args []string, constraint string) int {
+	cons, ok := semver.ParseConstraint(constraint)
+	if !ok {
+		fmt.Fprintf(c.stderr, "semver: invalid constraint %q\n", constraint)
+		return exitUsage
+	}
+
+	var best, bestPre semver.Semver
+	for _, v := range c.list(args) {
+		if cons.Check(v) && (!best.Valid || v.IsGreater(best)) {
+			best = v
+		}
+		if v.HasPre() && (!bestPre.Valid || v.IsGreater(bestPre)) {
+			bestPre = v
+		}
+	}
+	if !best.Valid && constraint == "" {
+		best = bestPre
+	}
+
+	if !best.Valid {
+		return exitFail
+	}
+
+	c.print(best)
+	return exitOK
+}
+
+// inputs returns args, or stdin lines when args are empty.
+func (c *cli) inputs(args []string) []string {
+	if len(args) > 0 {
+		return args
+	}
+
+	var lines []string
+	sc := bufio.NewScanner(c.stdin)
+	for sc.Scan() {
+		if line := strings.TrimSpace(sc.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		fmt.Fprintf(c.stderr, "semver: read stdin: %v\n", err)
+	}
+
+	return lines
+}
+
+// list parses inputs, reporting and skipping invalid versions.
+func (c *cli) list(args []string) semver.List {
+	in := c.inputs(args)
+	ls := make(semver.List, 0, len(in))
+	for _, s := range in {
+		v, ok := semver.Parse(s)
+		if !ok {
+			fmt.Fprintf(c.stderr, "semver: skipping invalid version %q\n", s)
+			continue
+		}
+		ls = append(ls, v)
+	}
+
+	return ls
+}
+
+// print writes v in canonical form (build kept) or as JSON.
+func (c *cli) print(v semver.Semver) {
+	if c.json {
+		c.encode(toJSON(v))
+		return
+	}
+
+	fmt.Fprintln(c.stdout, v.Print(semver.PrintMaskCanonical|semver.PrintBuild))
+}
+
+// printList writes ls one per line, or as a JSON array.
+func (c *cli) printList(ls semver.List) {
+	if c.json {
+		out := make([]jsonVersion, len(ls))
+		for i, v := range ls {
+			out[i] = toJSON(v)
+		}
+		c.encode(out)
+		return
+	}
+
+	for _, v := range ls {
+		c.print(v)
+	}
+}
+
+// encode writes x as a JSON line.
+func (c *cli) encode(x any) int {
+	if err := json.NewEncoder(c.stdout).Encode(x); err != nil {
+		fmt.Fprintf(c.stderr, "semver: %v\n", err)
+		return exitFail
+	}
+
+	return exitOK
+}
+
+// toJSON converts v to its JSON form.
+func toJSON(v semver.Semver) jsonVersion {
+	return jsonVersion{
+		Original:   v.Original,
+		Canonical:  v.Canonical(),
+		Prerelease: v.Prerelease,
+		Build:      v.Build,
+		Major:      v.Major,
+		Minor:      v.Minor,
+		Patch:      v.Patch,
+		Valid:      v.Valid,
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestRun covers every subcommand with args and stdin input.
+func TestRun(t *testing.T) {
+	tests := []struct {
+		args   []string
+		stdin  string
+		want   string
+		status int
+	}{
+		{[]string{"parse", "1.2", "v1.2.3-rc.1+b"}, "", "v1.2.0\nv1.2.3-rc.1+b\n", exitOK},
+		{[]string{"parse", "bad"}, "", "", exitFail},
+		{[]string{"parse", "-json", "1.2.3"}, "", `{"original":"1.2.3","canonical":"v1.2.3","major":1,"minor":2,"patch":3,"valid":true}` + "\n", exitOK},
+		{[]string{"compare", "1.2.3", "1.2.3-rc.1"}, "", "1\n", exitOK},
+		{[]string{"compare", "1.2.3"}, "", "", exitUsage},
+		{[]string{"sort"}, "2.0.0\nbad\n1.0.0\n1.0.0-rc.1\n", "v1.0.0-rc.1\nv1.0.0\nv2.0.0\n", exitOK},
+		{[]string{"sort", "-r", "1.0.0", "2.0.0"}, "", "v2.0.0\nv1.0.0\n", exitOK},
+		{[]string{"bump", "minor", "1.2.3-rc.1"}, "", "v1.3.0\n", exitOK},
+		{[]string{"bump", "pre", "1.2.3-rc.1"}, "", "v1.2.3-rc.2\n", exitOK},
+		{[]string{"bump", "pre", "1.2.3"}, "", "v1.2.4-rc.1\n", exitOK},
+		{[]string{"bump", "huge", "1.2.3"}, "", "", exitUsage},
+		{[]string{"parse", "-r", "1.2.3"}, "", "", exitUsage},
+		{[]string{"sort", "-c", "^1", "1.2.3"}, "", "", exitUsage},
+		{[]string{"latest", "-r", "1.2.3"}, "", "", exitUsage},
+		{[]string{"check", "^1.2", "1.1.0", "1.5.0", "2.0.0"}, "", "v1.5.0\n", exitOK},
+		{[]string{"check", "^3"}, "1.0.0\n", "", exitFail},
+		{[]string{"latest"}, "1.0.0\n1.10.0\n1.9.0\n", "v1.10.0\n", exitOK},
+		{[]string{"latest"}, "2.0.0-rc.1\n2.0.0-beta.3\n", "v2.0.0-rc.1\n", exitOK},
+		{[]string{"latest", "1.9.0", "2.0.0-rc.1"}, "", "v1.9.0\n", exitOK},
+		{[]string{"latest", "-c", ">=2", "2.0.0-rc.1"}, "", "", exitFail},
+		{[]string{"latest", "-c", "<1.10", "1.0.0", "1.10.0", "1.9.0"}, "", "v1.9.0\n", exitOK},
+		{[]string{"nope"}, "", "", exitUsage},
+		{nil, "", "", exitUsage},
+	}
+
+	for _, tt := range tests {
+		var out, errOut bytes.Buffer
+		status := run(tt.args, strings.NewReader(tt.stdin), &out, &errOut)
+		if status != tt.status || out.String() != tt.want {
+			t.Errorf("run(%q) = %d %q, want %d %q (stderr %q)",
+				tt.args, status, out.String(), tt.status, tt.want, errOut.String())
+		}
+	}
+}
+
+// TestRun_Suggest checks the did-you-mean hint for invalid versions.
+func TestRun_Suggest(t *testing.T) {
+	var out, errOut bytes.Buffer
+	if status := run([]string{"parse", "01.2.3"}, strings.NewReader(""), &out, &errOut); status != exitFail {
+		t.Fatalf("status = %d, want %d", status, exitFail)
+	}
+
+	want := "semver: invalid version \"01.2.3\", did you mean \"1.2.3\"?\n"
+	if errOut.String() != want {
+		t.Fatalf("stderr = %q, want %q", errOut.String(), want)
+	}
+}
@@ -0,0 +1,23 @@
+package semver
+
+import "strings"
+
+// Compact returns v detached from its input: Prerelease and Build are copied
+// into standalone strings and Original is dropped, so the parsed input can
+// be garbage collected. Use it for long-lived caches of many versions;
+// combine with StripBuild when build metadata is not needed either.
+//
+// The compacted version compares, prints and encodes like v, except that an
+// uppercase 'V' prefix is rendered as 'v'. Invalid versions keep a copy of
+// Original since it is all they carry.
+func (v Semver) Compact() Semver {
+	if !v.Valid {
+		return Semver{Original: strings.Clone(v.Original), Flags: v.Flags}
+	}
+
+	v.Original = ""
+	v.Prerelease = strings.Clone(v.Prerelease)
+	v.Build = strings.Clone(v.Build)
+
+	return v
+}
@@ -0,0 +1,41 @@
+package semver
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestCompact(t *testing.T) {
+	for _, s := range []string{"v1.2.3-rc.1+build.5", "1.2", "V2.0.0", "bad"} {
+		v, _ := Parse(s)
+		c := v.Compact()
+
+		if !v.IsEqual(c) || c.Valid != v.Valid || c.Flags != v.Flags {
+			t.Errorf("Compact(%q) = %+v, not equal to %+v", s, c, v)
+		}
+		if !v.Valid {
+			if c.Original != s {
+				t.Errorf("Compact(%q).Original = %q", s, c.Original)
+			}
+			continue
+		}
+
+		if c.Original != "" {
+			t.Errorf("Compact(%q).Original = %q, want dropped", s, c.Original)
+		}
+		if c.Canonical() != v.Canonical() || c.Build != v.Build {
+			t.Errorf("Compact(%q) renders %q+%q, want %q+%q", s, c.Canonical(), c.Build, v.Canonical(), v.Build)
+		}
+		if v.HasPre() && unsafe.StringData(c.Prerelease) == unsafe.StringData(v.Prerelease) {
+			t.Errorf("Compact(%q).Prerelease shares memory with Original", s)
+		}
+	}
+
+	c := MustParse("v1.2.3+b").Compact()
+	if got := c.String(); got != "v1.2.3+b" {
+		t.Errorf("compact String = %q, want v1.2.3+b", got)
+	}
+	if got := MustParse("V1.2.3").Compact(); got.String() != "v1.2.3" {
+		t.Errorf("compact String of V1.2.3 = %q, want v1.2.3", got.String())
+	}
+}
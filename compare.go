@@ -0,0 +1,156 @@
+package semver
+
+import (
+	"slices"
+	"strings"
+)
+
+// Compare returns a.Compare(b). It has the shape expected by
+// slices.SortFunc, slices.MaxFunc, slices.BinarySearchFunc and similar APIs:
+//
+//	newest := slices.MaxFunc(vs, semver.Compare)
+func Compare(a, b Semver) int {
+	return a.Compare(b)
+}
+
+// Less reports whether a has lower precedence than b.
+func Less(a, b Semver) bool {
+	return a.Compare(b) < 0
+}
+
+// Max returns the version of highest precedence in vs, the first one wins
+// among equal versions. Returns the zero (invalid) Semver if vs is empty.
+func Max(vs ...Semver) Semver {
+	var m Semver
+	for i, v := range vs {
+		if i == 0 || v.Compare(m) > 0 {
+			m = v
+		}
+	}
+
+	return m
+}
+
+// Min returns the version of lowest precedence in vs, the first one wins
+// among equal versions. Returns the zero (invalid) Semver if vs is empty.
+func Min(vs ...Semver) Semver {
+	var m Semver
+	for i, v := range vs {
+		if i == 0 || v.Compare(m) < 0 {
+			m = v
+		}
+	}
+
+	return m
+}
+
+// CompareStringsOptions tunes CompareStringsWith.
+type CompareStringsOptions struct {
+	// Coerce retries strings that do not parse after trimming space,
+	// stripping tag prefixes ("release-1.2.3") and dropping a fourth
+	// component ("1.2.3.4"), see Normalizer.
+	Coerce bool
+}
+
+// coerceNormalizer is the Normalizer used by CompareStringsOptions.Coerce.
+var coerceNormalizer = NewNormalizer(NormalizeTrimSpace, NormalizeStripPrefix, NormalizeCoerceRevision)
+
+// CompareStrings parses a and b and compares them as versions, so raw
+// string slices sort without building a List first:
+//
+//	slices.SortFunc(tags, semver.CompareStrings)
+//
+// The order is total and stable: valid versions by precedence, ties (such
+// as "1.2.3" and "v1.2.3+b") by byte order of the strings; strings that are
+// not versions sort before all versions, among themselves in NaturalCompare
+// order. Returns 0 only for identical strings. See CompareStringsWith to
+// coerce almost-versions.
+func CompareStrings(a, b string) int {
+	return CompareStringsWith(a, b, CompareStringsOptions{})
+}
+
+// CompareStringsWith is CompareStrings with options.
+func CompareStringsWith(a, b string, opts CompareStringsOptions) int {
+	return compareParsed(a, b, parseForCompare(a, opts), parseForCompare(b, opts))
+}
+
+// compareParsed implements the CompareStrings order for strings a and b
+// parsed as va and vb.
+func compareParsed(a, b string, va, vb Semver) int {
+	switch {
+	case va.Valid && vb.Valid:
+		if c := va.Compare(vb); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	case !va.Valid && !vb.Valid:
+		return NaturalCompare(a, b)
+	case va.Valid:
+		return 1
+	}
+
+	return -1
+}
+
+// parseForCompare parses s, coercing it if allowed.
+func parseForCompare(s string, opts CompareStringsOptions) Semver {
+	v, ok := Parse(s)
+	if !ok && opts.Coerce {
+		res, _ := coerceNormalizer.Normalize(s)
+		v = res.Version
+	}
+
+	return v
+}
+
+// SortStringsOptions tunes SortStringsWith.
+type SortStringsOptions struct {
+	// DropInvalid leaves strings that are not versions out of the result.
+	DropInvalid bool
+
+	// Desc sorts in descending order (invalid strings last).
+	Desc bool
+}
+
+// SortStrings returns a sorted copy of ss in ascending CompareStrings
+// order: strings that are not versions first, then versions by precedence
+// with ties broken by byte order. Each string is parsed once.
+func SortStrings(ss []string) []string {
+	return SortStringsWith(ss, SortStringsOptions{})
+}
+
+// SortStringsDesc is SortStrings in descending order, newest first.
+func SortStringsDesc(ss []string) []string {
+	return SortStringsWith(ss, SortStringsOptions{Desc: true})
+}
+
+// SortStringsWith returns a sorted copy of ss, see SortStrings.
+func SortStringsWith(ss []string, opts SortStringsOptions) []string {
+	type entry struct {
+		s string
+		v Semver
+	}
+
+	entries := make([]entry, 0, len(ss))
+	for _, s := range ss {
+		v, ok := Parse(s)
+		if ok || !opts.DropInvalid {
+			entries = append(entries, entry{s: s, v: v})
+		}
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		c := compareParsed(a.s, b.s, a.v, b.v)
+		if opts.Desc {
+			return -c
+		}
+		return c
+	})
+
+	out := make([]string, len(entries))
+	for i, e := range entries {
+		out[i] = e.s
+	}
+
+	return out
+}
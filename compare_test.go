@@ -0,0 +1,112 @@
+package semver
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCompareFuncs(t *testing.T) {
+	vs := mustList("1.2.3", "v2.0.0", "1.10.0-rc.1", "2.0.0+b", "0.9.0")
+
+	if got := Max(vs...); got.Original != "v2.0.0" {
+		t.Errorf("Max = %q, want v2.0.0", got.Original)
+	}
+	if got := Min(vs...); got.Original != "0.9.0" {
+		t.Errorf("Min = %q, want 0.9.0", got.Original)
+	}
+	if got := Max(); got.Valid {
+		t.Errorf("Max() = %+v, want invalid", got)
+	}
+	if got := Min(); got.Valid {
+		t.Errorf("Min() = %+v, want invalid", got)
+	}
+
+	// slices.MaxFunc returns the first maximal element as well
+	if got, want := slices.MaxFunc(vs, Compare), Max(vs...); got != want {
+		t.Errorf("slices.MaxFunc = %q, Max = %q", got.Original, want.Original)
+	}
+	if got, want := slices.MinFunc(vs, Compare), Min(vs...); got != want {
+		t.Errorf("slices.MinFunc = %q, Min = %q", got.Original, want.Original)
+	}
+
+	if !Less(vs[0], vs[1]) || Less(vs[1], vs[3]) || Less(vs[1], vs[0]) {
+		t.Error("Less mismatch")
+	}
+
+	sorted := slices.Clone(vs)
+	slices.SortStableFunc(sorted, Compare)
+	if !slices.IsSortedFunc(sorted, Compare) || sorted[0].Original != "0.9.0" || sorted[4].Original != "2.0.0+b" {
+		t.Errorf("SortStableFunc(Compare) = %v", sorted)
+	}
+}
+
+func TestCompareStrings(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		want   int
+		coerce int // result with Coerce
+	}{
+		{"1.2.3", "1.10.0", -1, -1},
+		{"v2.0.0", "1.9.9", 1, 1},
+		{"1.2.3", "1.2.3", 0, 0},
+		{"1.2.3", "v1.2.3", -1, -1},
+		{"1.2.3+b", "1.2.3+a", 1, 1},
+		{"1.0.0-rc.1", "1.0.0", -1, -1},
+		{"latest", "0.0.1", -1, -1},
+		{"0.0.1", "latest", 1, 1},
+		{"build-9", "build-10", -1, -1},
+		{"release-2.0.0", "1.0.0", -1, 1},
+		{" 1.0.0 ", "0.9.0", -1, 1},
+		{"1.2.3.4", "1.2.4", -1, -1},
+		{"1.2.3.4", "1.2.2", -1, 1},
+	}
+
+	for _, tt := range tests {
+		if got := CompareStrings(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareStrings(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := CompareStringsWith(tt.a, tt.b, CompareStringsOptions{Coerce: true}); got != tt.coerce {
+			t.Errorf("CompareStringsWith(%q, %q, Coerce) = %d, want %d", tt.a, tt.b, got, tt.coerce)
+		}
+	}
+
+	tags := []string{"v1.10.0", "edge", "1.2.0", "v1.2.0-rc.1", "build-10", "build-9", "1.2.0+b"}
+	slices.SortFunc(tags, CompareStrings)
+	want := []string{"build-9", "build-10", "edge", "v1.2.0-rc.1", "1.2.0", "1.2.0+b", "v1.10.0"}
+	if !slices.Equal(tags, want) {
+		t.Errorf("sorted = %v, want %v", tags, want)
+	}
+}
+
+func TestSortStrings(t *testing.T) {
+	in := []string{"v1.10.0", "edge", "1.2.0", "v1.2.0-rc.1", "build-10", "build-9", "1.2.0+b"}
+	orig := slices.Clone(in)
+
+	tests := []struct {
+		name string
+		got  []string
+		want []string
+	}{
+		{"asc", SortStrings(in), []string{"build-9", "build-10", "edge", "v1.2.0-rc.1", "1.2.0", "1.2.0+b", "v1.10.0"}},
+		{"desc", SortStringsDesc(in), []string{"v1.10.0", "1.2.0+b", "1.2.0", "v1.2.0-rc.1", "edge", "build-10", "build-9"}},
+		{"drop", SortStringsWith(in, SortStringsOptions{DropInvalid: true}), []string{"v1.2.0-rc.1", "1.2.0", "1.2.0+b", "v1.10.0"}},
+		{"drop desc", SortStringsWith(in, SortStringsOptions{DropInvalid: true, Desc: true}), []string{"v1.10.0", "1.2.0+b", "1.2.0", "v1.2.0-rc.1"}},
+		{"empty", SortStrings(nil), []string{}},
+	}
+
+	for _, tt := range tests {
+		if !slices.Equal(tt.got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+	if !slices.Equal(in, orig) {
+		t.Errorf("input modified: %v", in)
+	}
+
+	// agrees with CompareStrings
+	byFunc := slices.Clone(in)
+	slices.SortFunc(byFunc, CompareStrings)
+	if !slices.Equal(byFunc, SortStrings(in)) {
+		t.Errorf("SortFunc(CompareStrings) = %v, SortStrings = %v", byFunc, SortStrings(in))
+	}
+}
@@ -0,0 +1,88 @@
+package semver
+
+import "sort"
+
+// CompiledConstraint is a Constraint pre-compiled into sorted disjoint
+// intervals, so Check costs O(log n) in the number of intervals instead of
+// iterating every "||" alternative. Use it for long allowlists of ranges
+// evaluated many times; it gives the same results as Check and CheckWith of
+// the source constraint. Safe for concurrent use.
+type CompiledConstraint struct {
+	// all is the whole constraint, used for releases and IncludePrerelease.
+	all []interval
+
+	// pre holds, per MAJOR.MINOR.PATCH, the intervals of the alternatives
+	// that admit its prereleases under the npm rule (see Check).
+	pre map[[3]int][]interval
+
+	src Constraint
+}
+
+// Compile returns c pre-compiled for fast repeated checks.
+func (c Constraint) Compile() *CompiledConstraint {
+	cc := &CompiledConstraint{all: c.intervals(), src: c}
+
+	groups := make(map[[3]int][]interval)
+	for _, group := range c.groups {
+		seen := make(map[[3]int]bool)
+		for _, cmp := range group {
+			key := [3]int{cmp.v.Major, cmp.v.Minor, cmp.v.Patch}
+			if cmp.v.Flags&FlagHasPre == 0 || seen[key] {
+				continue
+			}
+			seen[key] = true
+			groups[key] = append(groups[key], groupIntervals(group)...)
+		}
+	}
+	if len(groups) > 0 {
+		cc.pre = make(map[[3]int][]interval, len(groups))
+		for key, ivs := range groups {
+			cc.pre[key] = mergeIntervals(ivs)
+		}
+	}
+
+	return cc
+}
+
+// String returns the source constraint, see Constraint.String.
+func (cc *CompiledConstraint) String() string {
+	return cc.src.String()
+}
+
+// Constraint returns the source constraint.
+func (cc *CompiledConstraint) Constraint() Constraint {
+	return cc.src
+}
+
+// Check reports whether v satisfies the constraint, see Constraint.Check.
+func (cc *CompiledConstraint) Check(v Semver) bool {
+	return cc.CheckWith(v, CheckOptions{})
+}
+
+// CheckWith is like Check with the behavior tuned by opts.
+func (cc *CompiledConstraint) CheckWith(v Semver, opts CheckOptions) bool {
+	if !v.Valid {
+		return false
+	}
+
+	if opts.IncludePrerelease || v.Flags&FlagHasPre == 0 {
+		return searchIntervals(cc.all, v)
+	}
+
+	return searchIntervals(cc.pre[[3]int{v.Major, v.Minor, v.Patch}], v)
+}
+
+// searchIntervals reports whether v lies within one of the disjoint
+// ascending ivs, by binary search for the first interval not below v.
+func searchIntervals(ivs []interval, v Semver) bool {
+	i := sort.Search(len(ivs), func(i int) bool {
+		hi := ivs[i].hi
+		if !hi.set {
+			return true
+		}
+		r := v.Compare(hi.v)
+		return r < 0 || r == 0 && hi.incl
+	})
+
+	return i < len(ivs) && ivs[i].contains(v)
+}
@@ -0,0 +1,87 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestCompiledConstraint checks that compiled constraints agree with Check
+// and CheckWith of their source.
+func TestCompiledConstraint(t *testing.T) {
+	constraints := []string{
+		"*",
+		"^1.2.0",
+		"~1.2.3 || ^3.1 || 5.x",
+		">=1.5.0-beta <2 || >=3.0.0-rc.1 <=3.0.0",
+		"^1.2 !=1.4.0",
+		"1.2.3 || 1.2.5 || 2.0.0-alpha",
+		"<0.0.0-0",
+		">1.0.0 <1.0.0",
+	}
+	versions := mustList(
+		"0.0.0", "1.0.0", "1.2.0", "1.2.3", "1.2.4", "1.2.5", "1.3.0", "1.4.0",
+		"1.4.1", "1.5.0-alpha", "1.5.0-beta", "1.5.0-rc.1", "1.9.9", "2.0.0-alpha",
+		"2.0.0", "3.0.0-beta", "3.0.0-rc.2", "3.0.0", "3.1.0", "3.9.0-rc.1",
+		"4.0.0", "5.0.0-rc.1", "5.3.0", "6.0.0", "bad",
+	)
+
+	for _, s := range constraints {
+		c := MustConstraint(s)
+		cc := c.Compile()
+		if cc.String() != c.String() {
+			t.Errorf("String() = %q, want %q", cc.String(), c.String())
+		}
+
+		for _, v := range versions {
+			if got, want := cc.Check(v), c.Check(v); got != want {
+				t.Errorf("%q: Check(%s) = %v, want %v", s, v.Original, got, want)
+			}
+
+			opts := CheckOptions{IncludePrerelease: true}
+			if got, want := cc.CheckWith(v, opts), c.CheckWith(v, opts); got != want {
+				t.Errorf("%q: CheckWith(%s, IncludePrerelease) = %v, want %v", s, v.Original, got, want)
+			}
+		}
+	}
+}
+
+// benchAllowlist returns an allowlist of n disjoint patch ranges.
+func benchAllowlist(n int) Constraint {
+	parts := make([]string, n)
+	for i := range parts {
+		parts[i] = fmt.Sprintf(">=%d.2.0 <%d.4.0", i, i)
+	}
+
+	return MustConstraint(strings.Join(parts, " || "))
+}
+
+// BenchmarkConstraintCheck_Allowlist benchmarks clause iteration over an
+// allowlist of 64 ranges, see BenchmarkCompiledConstraintCheck_Allowlist.
+func BenchmarkConstraintCheck_Allowlist(b *testing.B) {
+	c := benchAllowlist(64)
+	v := MustParse("60.3.1")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if c.Check(v) {
+			sinkInt++
+		}
+	}
+}
+
+// BenchmarkCompiledConstraintCheck_Allowlist benchmarks binary search over
+// the same allowlist compiled into intervals.
+func BenchmarkCompiledConstraintCheck_Allowlist(b *testing.B) {
+	cc := benchAllowlist(64).Compile()
+	v := MustParse("60.3.1")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if cc.Check(v) {
+			sinkInt++
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package semver
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ComponentRegistry records the versions of the components embedded in a
+// binary (the binary itself, plugins, bundled schemas) for /version
+// endpoints and skew checks. The zero value is ready to use and safe for
+// concurrent use; most programs use the process-wide registry through
+// RegisterComponent and ComponentVersions.
+type ComponentRegistry struct {
+	m  VersionMap
+	mu sync.RWMutex
+}
+
+// defaultComponents is the process-wide registry.
+var defaultComponents ComponentRegistry
+
+// Register records version for the component name. Registering the same
+// version again is a no-op; a different version fails with an error
+// wrapping ErrVersionExists, an empty name or invalid version with
+// ErrInvalidVersion.
+func (r *ComponentRegistry) Register(name, version string) error {
+	v, ok := Parse(version)
+	if !ok || name == "" {
+		return fmt.Errorf("%w: component %q version %q", ErrInvalidVersion, name, version)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cur, ok := r.m[name]; ok {
+		if cur.Original == v.Original {
+			return nil
+		}
+		return fmt.Errorf("%w: component %q already at %q", ErrVersionExists, name, cur.Original)
+	}
+
+	if r.m == nil {
+		r.m = make(VersionMap)
+	}
+	r.m[name] = v
+
+	return nil
+}
+
+// Snapshot returns a copy of the registered versions.
+func (r *ComponentRegistry) Snapshot() VersionMap {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make(VersionMap, len(r.m))
+	for name, v := range r.m {
+		out[name] = v
+	}
+
+	return out
+}
+
+// RegisterComponent records a component version in the process-wide
+// registry, see ComponentRegistry.Register. Versions are typically stamped
+// at link time:
+//
+//	// go build -ldflags "-X main.version=1.4.2"
+//	var version = "0.0.0-dev"
+//
+//	func init() {
+//		if err := semver.RegisterComponent("app", version); err != nil {
+//			panic(err)
+//		}
+//	}
+func RegisterComponent(name, version string) error {
+	return defaultComponents.Register(name, version)
+}
+
+// MustRegisterComponent is RegisterComponent panicking on error, for
+// package-level registration of stamped versions.
+func MustRegisterComponent(name, version string) {
+	if err := RegisterComponent(name, version); err != nil {
+		panic(err)
+	}
+}
+
+// ComponentVersions returns a snapshot of the process-wide registry.
+func ComponentVersions() VersionMap {
+	return defaultComponents.Snapshot()
+}
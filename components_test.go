@@ -0,0 +1,78 @@
+package semver
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestComponentRegistry(t *testing.T) {
+	var r ComponentRegistry
+	if got := r.Snapshot(); len(got) != 0 {
+		t.Errorf("zero Snapshot = %v", got)
+	}
+
+	if err := r.Register("app", "v1.4.2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Register("app", "v1.4.2"); err != nil {
+		t.Errorf("re-register same version = %v", err)
+	}
+	if err := r.Register("app", "1.4.2"); !errors.Is(err, ErrVersionExists) {
+		t.Errorf("re-register other spelling = %v, want ErrVersionExists", err)
+	}
+	if err := r.Register("schema", "not-a-version"); !errors.Is(err, ErrInvalidVersion) {
+		t.Errorf("invalid version = %v, want ErrInvalidVersion", err)
+	}
+	if err := r.Register("", "1.0.0"); !errors.Is(err, ErrInvalidVersion) {
+		t.Errorf("empty name = %v, want ErrInvalidVersion", err)
+	}
+
+	snap := r.Snapshot()
+	if err := r.Register("plugin", "0.3.0"); err != nil {
+		t.Fatal(err)
+	}
+	if len(snap) != 1 || snap["app"].Original != "v1.4.2" {
+		t.Errorf("Snapshot = %v", snap)
+	}
+	if got := r.Snapshot(); len(got) != 2 || got["plugin"].Original != "0.3.0" {
+		t.Errorf("Snapshot = %v", got)
+	}
+}
+
+func TestComponentRegistry_Concurrent(t *testing.T) {
+	var r ComponentRegistry
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_ = r.Register("app", "1.0.0")
+		}()
+		go func() {
+			defer wg.Done()
+			_ = r.Snapshot()
+		}()
+	}
+	wg.Wait()
+
+	if got := r.Snapshot(); got["app"].Original != "1.0.0" {
+		t.Errorf("Snapshot = %v", got)
+	}
+}
+
+func TestRegisterComponent(t *testing.T) {
+	defer func() { defaultComponents = ComponentRegistry{} }()
+
+	MustRegisterComponent("semver-test", "2.0.0")
+	if got := ComponentVersions()["semver-test"]; got.Original != "2.0.0" {
+		t.Errorf("ComponentVersions = %v", ComponentVersions())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustRegisterComponent did not panic")
+		}
+	}()
+	MustRegisterComponent("semver-test", "3.0.0")
+}
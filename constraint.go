@@ -0,0 +1,466 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Constraint is a parsed version range such as ">=1.2.0 <2.0.0 || ^3.1".
+// It is a disjunction ("||") of conjunctions (space or comma separated)
+// of primitive comparators. Range operators (~, ^, x-ranges, hyphen ranges)
+// are desugared into primitive comparators at parse time.
+//
+// Upper bounds produced by desugaring use the lowest possible prerelease
+// ("<2.0.0-0"), so "^1.2" never matches "2.0.0-rc.1".
+type Constraint struct {
+	// original input, trimmed
+	original string
+
+	// groups are OR-ed; comparators inside a group are AND-ed.
+	groups [][]comparator
+}
+
+// operator is a primitive comparison operator.
+type operator uint8
+
+const (
+	opEQ operator = iota // =
+	opNE                 // !=
+	opGT                 // >
+	opGE                 // >=
+	opLT                 // <
+	opLE                 // <=
+)
+
+// operatorStr maps operators to their textual form.
+var operatorStr = [...]string{opEQ: "=", opNE: "!=", opGT: ">", opGE: ">=", opLT: "<", opLE: "<="}
+
+// String returns the textual form of op.
+func (op operator) String() string {
+	return operatorStr[op]
+}
+
+// comparator is a single primitive "op version" term.
+type comparator struct {
+	// term is the source term this comparator was desugared from (e.g. "~1.2").
+	term string
+
+	v  Semver
+	op operator
+}
+
+// check reports whether v satisfies the comparator.
+func (c comparator) check(v Semver) bool {
+	r := v.Compare(c.v)
+	switch c.op {
+	case opEQ:
+		return r == 0
+	case opNE:
+		return r != 0
+	case opGT:
+		return r > 0
+	case opGE:
+		return r >= 0
+	case opLT:
+		return r < 0
+	case opLE:
+		return r <= 0
+	}
+
+	return false
+}
+
+// String renders the comparator as "OP vMAJOR.MINOR.PATCH[-PRERELEASE]".
+func (c comparator) String() string {
+	return c.op.String() + c.v.Canonical()
+}
+
+// ParseConstraint parses a version range.
+//
+// Supported syntax (npm/Masterminds compatible):
+//
+//	=1.2.3 !=1.2.3 >1.2.3 >=1.2.3 <1.2.3 <=1.2.3   primitive comparators
+//	1.2.3                                          exact match
+//	1.2, 1.2.x, 1.*, *                             x-ranges
+//	~1.2.3                                         >=1.2.3 <1.3.0-0
+//	^1.2.3, ^0.2.3, ^0.0.3                         >=1.2.3 <2.0.0-0, <0.3.0-0, <0.0.4-0
+//	1.2 - 1.4.5                                    >=1.2.0 <=1.4.5
+//	A B, A,B                                       both A and B
+//	A || B                                         either A or B
+//
+// The leading 'v'/'V' is optional on every version. Returns (zero, false) if
+// the input is not a valid constraint.
+func ParseConstraint(s string) (Constraint, bool) {
+	s = strings.TrimSpace(s)
+	c := Constraint{original: s}
+
+	for _, alt := range strings.Split(s, "||") {
+		group, ok := parseConstraintGroup(alt)
+		if !ok {
+			return Constraint{original: s}, false
+		}
+
+		c.groups = append(c.groups, group)
+	}
+
+	return c, true
+}
+
+// MustConstraint is like ParseConstraint but panics if s is not a valid constraint.
+func MustConstraint(s string) Constraint {
+	c, ok := ParseConstraint(s)
+	if !ok {
+		panic("semver: invalid constraint " + strconv.Quote(s))
+	}
+
+	return c
+}
+
+// String returns the constraint as it was given to ParseConstraint (trimmed).
+func (c Constraint) String() string {
+	return c.original
+}
+
+// CheckOptions tunes Constraint.CheckWith.
+// The zero value applies the npm prerelease rule, see Check.
+type CheckOptions struct {
+	// IncludePrerelease lets prerelease versions satisfy any range they
+	// fall into, like npm's includePrerelease option: the check goes by
+	// precedence alone, so "2.0.0-rc.1" satisfies ">=1.4" but not ">=2"
+	// (it sorts before 2.0.0). Gate, Eval and the versionhttp package check
+	// this way.
+	IncludePrerelease bool
+}
+
+// Check reports whether v satisfies the constraint.
+// Invalid versions never satisfy any constraint.
+//
+// Prerelease versions follow the npm rule: they only satisfy an alternative
+// that explicitly mentions a prerelease of the same MAJOR.MINOR.PATCH, so
+// "^1.0.0" does not match "1.5.0-alpha" but ">=1.5.0-beta <2" matches
+// "1.5.0-rc.1". Use CheckWith to disable it.
+func (c Constraint) Check(v Semver) bool {
+	return c.CheckWith(v, CheckOptions{})
+}
+
+// CheckWith is like Check with the behavior tuned by opts.
+func (c Constraint) CheckWith(v Semver, opts CheckOptions) bool {
+	if !v.Valid {
+		return false
+	}
+
+	for _, group := range c.groups {
+		if checkGroup(group, v) && (opts.IncludePrerelease || allowsPrerelease(group, v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkGroup reports whether v satisfies every comparator in group.
+func checkGroup(group []comparator, v Semver) bool {
+	for _, cmp := range group {
+		if !cmp.check(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// allowsPrerelease reports whether group admits v under the npm prerelease
+// rule: releases always pass, prereleases only if a comparator names a
+// prerelease of the same MAJOR.MINOR.PATCH.
+func allowsPrerelease(group []comparator, v Semver) bool {
+	if v.Flags&FlagHasPre == 0 {
+		return true
+	}
+
+	for _, cmp := range group {
+		if cmp.v.Flags&FlagHasPre != 0 &&
+			cmp.v.Major == v.Major && cmp.v.Minor == v.Minor && cmp.v.Patch == v.Patch {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseConstraintGroup parses one "||" alternative into AND-ed comparators.
+// An empty alternative matches any version.
+func parseConstraintGroup(s string) ([]comparator, bool) {
+	fields := strings.Fields(strings.ReplaceAll(s, ",", " "))
+	group := make([]comparator, 0, len(fields))
+
+	for i := 0; i < len(fields); i++ {
+		tok := fields[i]
+
+		// operator separated from its version by spaces (">= 1.2.3")
+		if isOperatorOnly(tok) {
+			if i+1 >= len(fields) {
+				return nil, false
+			}
+			i++
+			tok += fields[i]
+		}
+
+		// hyphen range "A - B"
+		if i+2 < len(fields) && fields[i+1] == "-" {
+			cmps, ok := parseHyphenTerm(tok, fields[i+2])
+			if !ok {
+				return nil, false
+			}
+			group = append(group, cmps...)
+			i += 2
+			continue
+		}
+
+		cmps, ok := parseTerm(tok)
+		if !ok {
+			return nil, false
+		}
+		group = append(group, cmps...)
+	}
+
+	return group, true
+}
+
+// isOperatorOnly reports whether tok is a bare operator without a version.
+func isOperatorOnly(tok string) bool {
+	switch tok {
+	case "=", "==", "!=", ">", ">=", "<", "<=", "~", "~>", "^":
+		return true
+	}
+
+	return false
+}
+
+// partial is a possibly incomplete version used in range terms ("1.2", "1.x").
+type partial struct {
+	v Semver
+
+	// number of explicitly given numeric components (0..3)
+	n int
+}
+
+// parsePartial parses "[v]MAJOR[.MINOR[.PATCH[-PRE][+BUILD]]]" where any
+// numeric component may be a wildcard ('x', 'X' or '*'). Components after a
+// wildcard must be wildcards too. "*" alone (or empty) has n == 0.
+func parsePartial(s string) (partial, bool) {
+	if s == "" || s == "*" || s == "x" || s == "X" {
+		v := Semver{Valid: true, Flags: FlagHasMajor | FlagHasMinor | FlagHasPatch}
+		v.Original = v.Print(PrintMaskDefault)
+		return partial{v: v}, true
+	}
+
+	core, rest := s, ""
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		core, rest = s[:i], s[i:]
+	}
+
+	if core != "" && (core[0] == 'v' || core[0] == 'V') {
+		core = core[1:]
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) > 3 {
+		return partial{}, false
+	}
+
+	n := 0
+	for _, p := range parts {
+		if p == "x" || p == "X" || p == "*" {
+			break
+		}
+		n++
+	}
+
+	// no numbers after a wildcard; pre/build only with a full version
+	for _, p := range parts[n:] {
+		if p != "x" && p != "X" && p != "*" {
+			return partial{}, false
+		}
+	}
+	if rest != "" && n < 3 {
+		return partial{}, false
+	}
+
+	full := strings.Join(parts[:n], ".")
+	if n == 0 {
+		full = "0"
+	}
+
+	v, ok := Parse(full + rest)
+	if !ok {
+		return partial{}, false
+	}
+
+	// normalize shorthand to a full version for comparisons
+	v.Flags |= FlagHasMinor | FlagHasPatch
+	v.Original = v.Print(PrintMaskDefault)
+
+	return partial{v: v, n: n}, true
+}
+
+// parseTerm parses a single range term into primitive comparators.
+func parseTerm(tok string) ([]comparator, bool) {
+	op := ""
+	for _, p := range []string{"~>", ">=", "<=", "!=", "==", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(tok, p) {
+			op = p
+			break
+		}
+	}
+
+	p, ok := parsePartial(tok[len(op):])
+	if !ok {
+		return nil, false
+	}
+
+	lo := p.v
+	mk := func(o operator, v Semver) comparator {
+		return comparator{term: tok, op: o, v: v}
+	}
+
+	switch op {
+	case "", "=", "==":
+		if p.n == 0 {
+			return []comparator{}, true
+		}
+		if p.n == 3 {
+			return []comparator{mk(opEQ, lo)}, true
+		}
+		return []comparator{mk(opGE, lo), mk(opLT, upperX(p))}, true
+
+	case "!=":
+		if p.n < 3 {
+			return nil, false
+		}
+		return []comparator{mk(opNE, lo)}, true
+
+	case ">":
+		if p.n == 0 {
+			// nothing is greater than "any version"
+			return []comparator{mk(opLT, withPreZero(lo))}, true
+		}
+		if p.n == 3 {
+			return []comparator{mk(opGT, lo)}, true
+		}
+		return []comparator{mk(opGE, upperRelease(p))}, true
+
+	case ">=":
+		if p.n == 0 {
+			return []comparator{}, true
+		}
+		return []comparator{mk(opGE, lo)}, true
+
+	case "<":
+		if p.n == 3 {
+			return []comparator{mk(opLT, lo)}, true
+		}
+		return []comparator{mk(opLT, withPreZero(lo))}, true
+
+	case "<=":
+		if p.n == 0 {
+			return []comparator{}, true
+		}
+		if p.n == 3 {
+			return []comparator{mk(opLE, lo)}, true
+		}
+		return []comparator{mk(opLT, upperX(p))}, true
+
+	case "~", "~>":
+		if p.n == 0 {
+			return []comparator{}, true
+		}
+		up := p
+		if up.n == 3 {
+			up.n = 2
+		}
+		return []comparator{mk(opGE, lo), mk(opLT, upperX(up))}, true
+
+	case "^":
+		if p.n == 0 {
+			return []comparator{}, true
+		}
+		return []comparator{mk(opGE, lo), mk(opLT, upperCaret(p))}, true
+	}
+
+	return nil, false
+}
+
+// parseHyphenTerm parses "A - B" into ">=A <=B" honoring partial bounds.
+func parseHyphenTerm(a, b string) ([]comparator, bool) {
+	lo, ok := parsePartial(a)
+	if !ok {
+		return nil, false
+	}
+	hi, ok := parsePartial(b)
+	if !ok {
+		return nil, false
+	}
+
+	term := a + " - " + b
+	cmps := []comparator{{term: term, op: opGE, v: lo.v}}
+	switch hi.n {
+	case 0:
+	case 3:
+		cmps = append(cmps, comparator{term: term, op: opLE, v: hi.v})
+	default:
+		cmps = append(cmps, comparator{term: term, op: opLT, v: upperX(hi)})
+	}
+
+	return cmps, true
+}
+
+// upperX returns the exclusive upper bound of x-range p ("1.2" -> "1.3.0-0").
+func upperX(p partial) Semver {
+	switch p.n {
+	case 1:
+		return boundary(p.v.Major+1, 0, 0)
+	case 2:
+		return boundary(p.v.Major, p.v.Minor+1, 0)
+	default:
+		return boundary(p.v.Major, p.v.Minor, p.v.Patch+1)
+	}
+}
+
+// upperRelease returns the lowest release above x-range p ("1.2" -> "1.3.0").
+func upperRelease(p partial) Semver {
+	return releaseOf(upperX(p))
+}
+
+// upperCaret returns the exclusive upper bound of "^p" honoring 0.x rules:
+// the first non-zero explicitly given component is incremented.
+func upperCaret(p partial) Semver {
+	v := p.v
+	switch {
+	case v.Major != 0 || p.n == 1:
+		return boundary(v.Major+1, 0, 0)
+	case v.Minor != 0 || p.n == 2:
+		return boundary(0, v.Minor+1, 0)
+	default:
+		return boundary(0, 0, v.Patch+1)
+	}
+}
+
+// boundary returns "MAJOR.MINOR.PATCH-0", the lowest version of that core.
+func boundary(major, minor, patch int) Semver {
+	v := Semver{
+		Major:      major,
+		Minor:      minor,
+		Patch:      patch,
+		Prerelease: "0",
+		Flags:      FlagHasMajor | FlagHasMinor | FlagHasPatch | FlagHasPre,
+		Valid:      true,
+	}
+	v.Original = v.Print(PrintMaskDefault)
+
+	return v
+}
+
+// withPreZero returns v with prerelease "0", the lowest version of its core.
+func withPreZero(v Semver) Semver {
+	return boundary(v.Major, v.Minor, v.Patch)
+}
@@ -0,0 +1,116 @@
+package semver
+
+import "testing"
+
+// TestParseConstraint_Invalid checks that malformed ranges are rejected.
+func TestParseConstraint_Invalid(t *testing.T) {
+	for _, in := range []string{
+		">=",
+		"1.2.3.4",
+		"1.x.3",
+		"1.2-rc.1",
+		"!=1.2",
+		"~bad",
+		">=1.2.3 <",
+		"1.2.3 - ",
+	} {
+		if _, ok := ParseConstraint(in); ok {
+			t.Errorf("ParseConstraint(%q) accepted invalid input", in)
+		}
+	}
+}
+
+// TestConstraint_Check covers operators, desugaring and prerelease bounds.
+func TestConstraint_Check(t *testing.T) {
+	tests := []struct {
+		c    string
+		v    string
+		want bool
+	}{
+		{"1.2.3", "1.2.3+build", true},
+		{"=v1.2.3", "1.2.4", false},
+		{"!=1.2.3", "1.2.4", true},
+		{">1.2.3", "1.2.3", false},
+		{">= 1.2.3", "1.2.3", true},
+		{"<1.2.3", "1.2.3-rc.1", false},
+		{"<=1.2", "1.2.9", true},
+		{"<=1.2", "1.3.0", false},
+		{">1.2", "1.2.9", false},
+		{">1.2", "1.3.0", true},
+		{"<1.2", "1.2.0-rc.1", false},
+
+		{"*", "0.0.1", true},
+		{"", "5.0.0", true},
+		{"1.x", "1.9.9", true},
+		{"1.x", "2.0.0-rc.1", false},
+		{"1.2.*", "1.3.0", false},
+
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"~1", "1.9.0", true},
+		{"~>1.2", "1.2.5", true},
+
+		{"^1.2.3", "1.9.0", true},
+		{"^1.2.3", "2.0.0-rc.1", false},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.0.3", "0.0.4", false},
+		{"^0", "0.9.0", true},
+		{"^0.0", "0.1.0", false},
+
+		{"1.2 - 1.4.5", "1.4.5", true},
+		{"1.2 - 1.4.5", "1.4.6", false},
+		{"1.2.3 - 1.4", "1.4.9", true},
+		{"1.2.3 - 1.4", "1.2.2", false},
+
+		{">=1.2.0, <1.3.0", "1.2.7", true},
+		{">=1.2.0 <1.3.0 || ^3", "3.4.0", true},
+		{">=1.2.0 <1.3.0 || ^3", "2.0.0", false},
+
+		{"^1", "bad", false},
+	}
+
+	for _, tt := range tests {
+		c, ok := ParseConstraint(tt.c)
+		if !ok {
+			t.Fatalf("ParseConstraint(%q) failed", tt.c)
+		}
+
+		v, _ := Parse(tt.v)
+		if got := c.Check(v); got != tt.want {
+			t.Errorf("%q.Check(%q) = %v, want %v", tt.c, tt.v, got, tt.want)
+		}
+	}
+}
+
+// TestConstraint_CheckPrerelease covers the npm prerelease rule and its opt-out.
+func TestConstraint_CheckPrerelease(t *testing.T) {
+	tests := []struct {
+		c       string
+		v       string
+		gated   bool
+		include bool
+	}{
+		{"^1.0.0", "1.5.0-alpha", false, true},
+		{"^1.0.0", "1.5.0", true, true},
+		{">=1.5.0-beta <2", "1.5.0-rc.1", true, true},
+		{">=1.5.0-beta <2", "1.6.0-rc.1", false, true},
+		{"<1.2.3", "1.2.3-rc.1", false, true},
+		{"<1.2.3-rc.2", "1.2.3-rc.1", true, true},
+		{"1.2.3-rc.1 || ^2", "1.2.3-rc.1", true, true},
+		{"1.2.3-rc.1 || ^2", "2.1.0-rc.1", false, true},
+		{"^1.0.0", "2.0.0-rc.1", false, false},
+		{"*", "1.0.0-rc.1", false, true},
+	}
+
+	for _, tt := range tests {
+		c := MustConstraint(tt.c)
+		v := MustParse(tt.v)
+		if got := c.Check(v); got != tt.gated {
+			t.Errorf("%q.Check(%q) = %v, want %v", tt.c, tt.v, got, tt.gated)
+		}
+		if got := c.CheckWith(v, CheckOptions{IncludePrerelease: true}); got != tt.include {
+			t.Errorf("%q.CheckWith(%q, IncludePrerelease) = %v, want %v", tt.c, tt.v, got, tt.include)
+		}
+	}
+}
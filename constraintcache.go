@@ -0,0 +1,117 @@
+package semver
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+)
+
+// ConstraintCache memoizes Constraint.Check results keyed by the constraint
+// string and the precedence identity of the version (see Key), for gateways
+// that evaluate the same few constraints against the same versions at a
+// high rate. It is safe for concurrent use and holds at most size results,
+// evicting the least recently used one. Build metadata and the 'v' prefix do
+// not affect the key, just as they do not affect Check. The zero value is a
+// cache of DefaultCacheSize results.
+type ConstraintCache struct {
+	items map[constraintCacheKey]*list.Element
+	order *list.List // front is most recently used
+	size  int
+	mu    sync.Mutex
+}
+
+// constraintCacheKey identifies one memoized evaluation.
+type constraintCacheKey struct {
+	constraint string
+	version    Key
+}
+
+// constraintCacheEntry is the value of a ConstraintCache list element.
+type constraintCacheEntry struct {
+	key constraintCacheKey
+	ok  bool
+}
+
+// NewConstraintCache returns a ConstraintCache holding at most size results
+// (size <= 0 means DefaultCacheSize).
+func NewConstraintCache(size int) *ConstraintCache {
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+
+	return &ConstraintCache{
+		items: make(map[constraintCacheKey]*list.Element),
+		order: list.New(),
+		size:  size,
+	}
+}
+
+// Check reports whether v satisfies constraint, see Constraint.Check,
+// evaluating it on the first use of the pair only. Invalid versions never
+// satisfy and are not cached; an invalid constraint fails with an error
+// wrapping ErrInvalidConstraint and is not cached either.
+func (c *ConstraintCache) Check(constraint string, v Semver) (bool, error) {
+	if !v.Valid {
+		if _, ok := ParseConstraint(constraint); !ok {
+			return false, fmt.Errorf("%w: %q", ErrInvalidConstraint, constraint)
+		}
+		return false, nil
+	}
+
+	key := constraintCacheKey{constraint: constraint, version: v.Key()}
+
+	c.mu.Lock()
+	if e, hit := c.items[key]; hit {
+		c.order.MoveToFront(e)
+		ok := e.Value.(*constraintCacheEntry).ok
+		c.mu.Unlock()
+		return ok, nil
+	}
+	c.mu.Unlock()
+
+	cons, ok := ParseConstraint(constraint)
+	if !ok {
+		return false, fmt.Errorf("%w: %q", ErrInvalidConstraint, constraint)
+	}
+	ok = cons.Check(v)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.items == nil {
+		c.items = make(map[constraintCacheKey]*list.Element)
+		c.order = list.New()
+		if c.size <= 0 {
+			c.size = DefaultCacheSize
+		}
+	}
+	if _, hit := c.items[key]; hit {
+		return ok, nil
+	}
+	c.items[key] = c.order.PushFront(&constraintCacheEntry{key: key, ok: ok})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*constraintCacheEntry).key)
+	}
+
+	return ok, nil
+}
+
+// Len returns the number of memoized results.
+func (c *ConstraintCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.items)
+}
+
+// Reset drops all memoized results.
+func (c *ConstraintCache) Reset() {
+	c.mu.Lock()
+	clear(c.items)
+	if c.order != nil {
+		c.order.Init()
+	}
+	c.mu.Unlock()
+}
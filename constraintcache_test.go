@@ -0,0 +1,74 @@
+package semver
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+// TestConstraintCache checks results, key normalization, LRU eviction and
+// concurrent use.
+func TestConstraintCache(t *testing.T) {
+	c := NewConstraintCache(2)
+
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{"^1.2.0", "1.4.0", true},
+		{"^1.2.0", "v1.4.0+build.7", true},
+		{"^1.2.0", "2.0.0", false},
+		{"^1.2.0", "1.5.0-rc.1", false},
+		{">=1.5.0-beta <2", "1.5.0-rc.1", true},
+		{"^1.2.0", "bad", false},
+	}
+	for _, tt := range tests {
+		v, _ := Parse(tt.version)
+		got, err := c.Check(tt.constraint, v)
+		if err != nil || got != tt.want {
+			t.Errorf("Check(%q, %q) = %v, %v; want %v", tt.constraint, tt.version, got, err, tt.want)
+		}
+	}
+	if c.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2 (bounded)", c.Len())
+	}
+
+	if _, err := c.Check(">=", MustParse("1.0.0")); !errors.Is(err, ErrInvalidConstraint) {
+		t.Errorf("Check(invalid constraint) err = %v, want ErrInvalidConstraint", err)
+	}
+
+	// "=1.0.0" is used again after ">=1", so "^1" evicts ">=1".
+	c.Reset()
+	v := MustParse("1.0.0")
+	for _, cons := range []string{"=1.0.0", ">=1", "=1.0.0", "^1"} {
+		c.Check(cons, v)
+	}
+	c.mu.Lock()
+	_, hasA := c.items[constraintCacheKey{"=1.0.0", v.Key()}]
+	_, hasB := c.items[constraintCacheKey{">=1", v.Key()}]
+	c.mu.Unlock()
+	if !hasA || hasB {
+		t.Fatalf("LRU entries: =1.0.0 %v (want true), >=1 %v (want false)", hasA, hasB)
+	}
+
+	var zero ConstraintCache
+	zero.Reset()
+	if ok, err := zero.Check("^1", v); !ok || err != nil || zero.Len() != 1 {
+		t.Fatalf("zero ConstraintCache: Check = %v, %v; Len = %d", ok, err, zero.Len())
+	}
+
+	c = NewConstraintCache(0)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, s := range benchInputs {
+				v, _ := Parse(s)
+				c.Check("^1", v)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,89 @@
+package semver
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ParsePURLVersion extracts and parses the version of a package URL:
+// "pkg:golang/github.com/x/y@v1.2.3?type=module" ->
+// ("pkg:golang/github.com/x/y?type=module", v1.2.3). The version is
+// percent-decoded; coords is purl without "@version", keeping qualifiers
+// and subpath, so it identifies the package across versions. A purl without
+// a version, or whose version is not semver, yields ok == false with coords
+// still set and v holding the raw version as an invalid Semver.
+func ParsePURLVersion(purl string) (coords string, v Semver, ok bool) {
+	if !strings.HasPrefix(purl, "pkg:") {
+		return purl, Semver{Original: purl, Valid: false}, false
+	}
+
+	end := len(purl)
+	if i := strings.IndexAny(purl, "?#"); i >= 0 {
+		end = i
+	}
+
+	at := strings.LastIndexByte(purl[:end], '@')
+	if at < 0 || at < strings.LastIndexByte(purl[:end], '/') {
+		return purl, Semver{Valid: false}, false
+	}
+
+	coords = purl[:at] + purl[end:]
+	raw, err := url.PathUnescape(purl[at+1 : end])
+	if err != nil {
+		return coords, Semver{Original: purl[at+1 : end], Valid: false}, false
+	}
+
+	v, ok = Parse(raw)
+	return coords, v, ok
+}
+
+// cpeVersionField is the index of the version in a colon-split CPE 2.3
+// formatted string ("cpe", "2.3", part, vendor, product, version, ...).
+const cpeVersionField = 5
+
+// ParseCPEVersion extracts and parses the version of a CPE 2.3 formatted
+// string: "cpe:2.3:a:vendor:product:1.2.3:*:*:*:*:*:*:*" ->
+// ("cpe:2.3:a:vendor:product:*:*:*:*:*:*:*:*", 1.2.3). The version is
+// unescaped ("1\.2\.3"); coords is cpe with the version replaced by the
+// ANY value "*", so it identifies the product across versions. A CPE
+// without a version ("*" or "-"), or whose version is not semver, yields
+// ok == false with coords still set.
+func ParseCPEVersion(cpe string) (coords string, v Semver, ok bool) {
+	start, end, field := -1, len(cpe), 0
+	for i := 0; i < len(cpe); i++ {
+		switch cpe[i] {
+		case '\\':
+			i++
+		case ':':
+			field++
+			if field == cpeVersionField {
+				start = i + 1
+			} else if field == cpeVersionField+1 {
+				end = i
+			}
+		}
+		if field > cpeVersionField {
+			break
+		}
+	}
+	if !strings.HasPrefix(cpe, "cpe:2.3:") || start < 0 {
+		return cpe, Semver{Original: cpe, Valid: false}, false
+	}
+
+	coords = cpe[:start] + "*" + cpe[end:]
+	raw := cpe[start:end]
+	if raw == "*" || raw == "-" {
+		return coords, Semver{Valid: false}, false
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(raw); i++ {
+		if raw[i] == '\\' && i+1 < len(raw) {
+			i++
+		}
+		b.WriteByte(raw[i])
+	}
+
+	v, ok = Parse(b.String())
+	return coords, v, ok
+}
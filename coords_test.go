@@ -0,0 +1,62 @@
+package semver
+
+import "testing"
+
+func TestParsePURLVersion(t *testing.T) {
+	tests := []struct {
+		in, coords, version string
+		ok                  bool
+	}{
+		{"pkg:golang/github.com/x/y@v1.2.3", "pkg:golang/github.com/x/y", "v1.2.3", true},
+		{"pkg:npm/%40scope/name@2.0.0-rc.1?arch=x64#lib", "pkg:npm/%40scope/name?arch=x64#lib", "2.0.0-rc.1", true},
+		{"pkg:npm/@scope/name@1.0.0", "pkg:npm/@scope/name", "1.0.0", true},
+		{"pkg:cargo/serde@1.0.0%2Bbuild.1", "pkg:cargo/serde", "1.0.0+build.1", true},
+		{"pkg:deb/debian/curl@7.50.3-1", "pkg:deb/debian/curl", "7.50.3-1", true},
+		{"pkg:pypi/django@1.11.1rc1", "pkg:pypi/django", "", false},
+		{"pkg:npm/@scope/name", "pkg:npm/@scope/name", "", false},
+		{"pkg:golang/github.com/x/y", "pkg:golang/github.com/x/y", "", false},
+		{"github.com/x/y@v1.2.3", "github.com/x/y@v1.2.3", "", false},
+	}
+
+	for _, tt := range tests {
+		coords, v, ok := ParsePURLVersion(tt.in)
+		if coords != tt.coords || ok != tt.ok {
+			t.Errorf("ParsePURLVersion(%q) = %q, ok=%v; want %q, ok=%v", tt.in, coords, ok, tt.coords, tt.ok)
+		}
+		if ok && v.Original != tt.version {
+			t.Errorf("ParsePURLVersion(%q) version = %q, want %q", tt.in, v.Original, tt.version)
+		}
+	}
+}
+
+func TestParseCPEVersion(t *testing.T) {
+	tests := []struct {
+		in, coords, version string
+		ok                  bool
+	}{
+		{
+			"cpe:2.3:a:vendor:product:1.2.3:*:*:*:*:*:*:*",
+			"cpe:2.3:a:vendor:product:*:*:*:*:*:*:*:*", "1.2.3", true,
+		},
+		{
+			`cpe:2.3:a:ven\:dor:product:1\.2\.3-rc\.1:*:*:*:*:*:*:*`,
+			`cpe:2.3:a:ven\:dor:product:*:*:*:*:*:*:*:*`, "1.2.3-rc.1", true,
+		},
+		{"cpe:2.3:a:vendor:product:2.0", "cpe:2.3:a:vendor:product:*", "2.0", true},
+		{"cpe:2.3:a:vendor:product:*:*:*:*:*:*:*:*", "cpe:2.3:a:vendor:product:*:*:*:*:*:*:*:*", "", false},
+		{"cpe:2.3:a:vendor:product:-:*:*:*:*:*:*:*", "cpe:2.3:a:vendor:product:*:*:*:*:*:*:*:*", "", false},
+		{"cpe:2.3:a:vendor:product:2021r1:*:*:*:*:*:*:*", "cpe:2.3:a:vendor:product:*:*:*:*:*:*:*:*", "", false},
+		{"cpe:2.3:a:vendor", "cpe:2.3:a:vendor", "", false},
+		{"cpe:/a:vendor:product:1.2.3", "cpe:/a:vendor:product:1.2.3", "", false},
+	}
+
+	for _, tt := range tests {
+		coords, v, ok := ParseCPEVersion(tt.in)
+		if coords != tt.coords || ok != tt.ok {
+			t.Errorf("ParseCPEVersion(%q) = %q, ok=%v; want %q, ok=%v", tt.in, coords, ok, tt.coords, tt.ok)
+		}
+		if ok && v.Original != tt.version {
+			t.Errorf("ParseCPEVersion(%q) version = %q, want %q", tt.in, v.Original, tt.version)
+		}
+	}
+}
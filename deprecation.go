@@ -0,0 +1,72 @@
+package semver
+
+// DeprecationStatus is the lifecycle state of an API at a version,
+// see Deprecation.Status.
+type DeprecationStatus uint8
+
+const (
+	StatusUnavailable DeprecationStatus = iota // before Introduced
+	StatusActive                               // introduced, not yet deprecated
+	StatusDeprecated                           // deprecated, not yet removed
+	StatusRemoved                              // at or after Removed
+)
+
+// deprecationStatusNames are the String forms of DeprecationStatus.
+var deprecationStatusNames = [...]string{
+	StatusUnavailable: "unavailable",
+	StatusActive:      "active",
+	StatusDeprecated:  "deprecated",
+	StatusRemoved:     "removed",
+}
+
+// String returns the lowercase name of s.
+func (s DeprecationStatus) String() string {
+	if int(s) < len(deprecationStatusNames) {
+		return deprecationStatusNames[s]
+	}
+
+	return "unknown"
+}
+
+// Deprecation is the lifecycle of an API (endpoint, field, flag) in terms
+// of the versions it was introduced, deprecated and removed in. Invalid
+// (zero) versions mean the step has not happened: no Introduced means
+// available from the start, no Removed means never removed.
+type Deprecation struct {
+	Introduced Semver
+	Deprecated Semver
+	Removed    Semver
+}
+
+// Status returns the lifecycle state at v by precedence together with a
+// hint for humans, e.g. "deprecated since v2.0.0, removed in v3.0.0".
+// An invalid v yields StatusUnavailable.
+func (d Deprecation) Status(v Semver) (DeprecationStatus, string) {
+	switch {
+	case !v.Valid:
+		return StatusUnavailable, "invalid version"
+
+	case d.Introduced.Valid && v.IsLower(d.Introduced):
+		return StatusUnavailable, "introduced in " + d.Introduced.Canonical()
+
+	case d.Removed.Valid && !v.IsLower(d.Removed):
+		return StatusRemoved, "removed in " + d.Removed.Canonical()
+
+	case d.Deprecated.Valid && !v.IsLower(d.Deprecated):
+		hint := "deprecated since " + d.Deprecated.Canonical()
+		if d.Removed.Valid {
+			hint += ", removed in " + d.Removed.Canonical()
+		}
+		return StatusDeprecated, hint
+	}
+
+	hint := "active"
+	if d.Introduced.Valid {
+		hint += " since " + d.Introduced.Canonical()
+	}
+	if d.Deprecated.Valid {
+		hint += ", deprecated in " + d.Deprecated.Canonical()
+	}
+
+	return StatusActive, hint
+}
@@ -0,0 +1,45 @@
+package semver
+
+import "testing"
+
+func TestDeprecation_Status(t *testing.T) {
+	d := Deprecation{
+		Introduced: MustParse("1.4.0"),
+		Deprecated: MustParse("2.0.0"),
+		Removed:    MustParse("3.0.0"),
+	}
+
+	tests := []struct {
+		v      string
+		status DeprecationStatus
+		hint   string
+	}{
+		{"1.3.9", StatusUnavailable, "introduced in v1.4.0"},
+		{"1.4.0-rc.1", StatusUnavailable, "introduced in v1.4.0"},
+		{"1.4.0", StatusActive, "active since v1.4.0, deprecated in v2.0.0"},
+		{"2.0.0", StatusDeprecated, "deprecated since v2.0.0, removed in v3.0.0"},
+		{"3.0.0-rc.1", StatusDeprecated, "deprecated since v2.0.0, removed in v3.0.0"},
+		{"v3.0.0+b", StatusRemoved, "removed in v3.0.0"},
+		{"bad", StatusUnavailable, "invalid version"},
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.v)
+		status, hint := d.Status(v)
+		if status != tt.status || hint != tt.hint {
+			t.Errorf("Status(%q) = %s, %q, want %s, %q", tt.v, status, hint, tt.status, tt.hint)
+		}
+	}
+
+	// open-ended lifecycle
+	open := Deprecation{Deprecated: MustParse("2.0.0")}
+	if s, hint := open.Status(MustParse("0.1.0")); s != StatusActive || hint != "active, deprecated in v2.0.0" {
+		t.Errorf("open Status(0.1.0) = %s, %q", s, hint)
+	}
+	if s, hint := open.Status(MustParse("9.0.0")); s != StatusDeprecated || hint != "deprecated since v2.0.0" {
+		t.Errorf("open Status(9.0.0) = %s, %q", s, hint)
+	}
+	if got := DeprecationStatus(9).String(); got != "unknown" {
+		t.Errorf("String of unknown status = %q", got)
+	}
+}
@@ -0,0 +1,168 @@
+package distver
+
+import "github.com/woozymasta/semver"
+
+// Deb is a Debian package version "[EPOCH:]UPSTREAM[-REVISION]".
+type Deb struct {
+	// Upstream the upstream version
+	Upstream string
+
+	// Revision the Debian revision, empty for native packages
+	Revision string
+
+	// Epoch numeric epoch, 0 if absent
+	Epoch int
+}
+
+// ParseDeb parses a Debian package version. The upstream part must start
+// with a digit and consist of [A-Za-z0-9.+~-]; the revision of [A-Za-z0-9.+~].
+func ParseDeb(s string) (Deb, bool) {
+	epoch, up, rev, ok := splitEVR(s)
+	if !ok || !isDigit(up[0]) {
+		return Deb{}, false
+	}
+
+	for i := 0; i < len(up); i++ {
+		if c := up[i]; !isDigit(c) && !isAlpha(c) && c != '.' && c != '+' && c != '~' && c != '-' {
+			return Deb{}, false
+		}
+	}
+	for i := 0; i < len(rev); i++ {
+		if c := rev[i]; !isDigit(c) && !isAlpha(c) && c != '.' && c != '+' && c != '~' {
+			return Deb{}, false
+		}
+	}
+
+	return Deb{Epoch: epoch, Upstream: up, Revision: rev}, true
+}
+
+// String renders the version in "[EPOCH:]UPSTREAM[-REVISION]" form.
+func (d Deb) String() string {
+	s := d.Upstream
+	if d.Epoch != 0 {
+		s = itoa(d.Epoch) + ":" + s
+	}
+	if d.Revision != "" {
+		s += "-" + d.Revision
+	}
+
+	return s
+}
+
+// Compare compares d with o using dpkg ordering: epoch numerically, then
+// upstream and revision with the dpkg algorithm where '~' sorts before
+// everything, even the end of the string ("1.0~rc1" < "1.0").
+// Returns -1, 0 or +1.
+func (d Deb) Compare(o Deb) int {
+	if d.Epoch != o.Epoch {
+		return sign(d.Epoch - o.Epoch)
+	}
+	if c := debCompare(d.Upstream, o.Upstream); c != 0 {
+		return c
+	}
+
+	return debCompare(d.Revision, o.Revision)
+}
+
+// Semver converts d to Semver: "1.2.3~rc1-2" -> "1.2.3-rc1+2".
+// Returns false for non-zero epochs or upstream versions that are not semver.
+func (d Deb) Semver() (semver.Semver, bool) {
+	return toSemver(d.Epoch, d.Upstream, d.Revision)
+}
+
+// CompareDeb parses and compares two Debian versions.
+// Invalid versions sort before valid ones.
+func CompareDeb(a, b string) int {
+	da, okA := ParseDeb(a)
+	db, okB := ParseDeb(b)
+	switch {
+	case !okA && !okB:
+		return 0
+	case !okA:
+		return -1
+	case !okB:
+		return 1
+	}
+
+	return da.Compare(db)
+}
+
+// debOrder returns the dpkg sort weight of a non-digit character;
+// 0 stands for the end of the string.
+func debOrder(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+
+	c := s[i]
+	switch {
+	case isDigit(c):
+		return 0
+	case isAlpha(c):
+		return int(c)
+	case c == '~':
+		return -1
+	default:
+		return int(c) + 256
+	}
+}
+
+// debCompare is dpkg's verrevcmp.
+func debCompare(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		// non-digit prefix
+		for i < len(a) && !isDigit(a[i]) || j < len(b) && !isDigit(b[j]) {
+			ac, bc := debOrder(a, i), debOrder(b, j)
+			if ac != bc {
+				return sign(ac - bc)
+			}
+			i++
+			j++
+		}
+
+		// numeric part, leading zeros ignored
+		for i < len(a) && a[i] == '0' {
+			i++
+		}
+		for j < len(b) && b[j] == '0' {
+			j++
+		}
+
+		firstDiff := 0
+		for i < len(a) && isDigit(a[i]) && j < len(b) && isDigit(b[j]) {
+			if firstDiff == 0 {
+				firstDiff = int(a[i]) - int(b[j])
+			}
+			i++
+			j++
+		}
+		if i < len(a) && isDigit(a[i]) {
+			return 1
+		}
+		if j < len(b) && isDigit(b[j]) {
+			return -1
+		}
+		if firstDiff != 0 {
+			return sign(firstDiff)
+		}
+	}
+
+	return 0
+}
+
+// itoa converts a non-negative int to its decimal representation.
+func itoa(n int) string {
+	if n == 0 {
+		return "0"
+	}
+
+	var buf [20]byte
+	i := len(buf)
+	for ; n > 0; n /= 10 {
+		i--
+		buf[i] = byte('0' + n%10)
+	}
+
+	return string(buf[i:])
+}
@@ -0,0 +1,92 @@
+/*
+Package distver implements Debian (dpkg) and RPM version ordering with
+conversion to semver.Semver where the version can be represented.
+
+Both formats share the "[EPOCH:]VERSION[-RELEASE]" shape but differ in how
+the version strings themselves are compared, see Deb.Compare and RPM.Compare.
+*/
+package distver
+
+import (
+	"strings"
+
+	"github.com/woozymasta/semver"
+)
+
+// splitEVR splits "[EPOCH:]VERSION[-RELEASE]" into its parts.
+// The release is everything after the last '-'.
+func splitEVR(s string) (epoch int, version, release string, ok bool) {
+	version = s
+	if i := strings.IndexByte(version, ':'); i >= 0 {
+		e := version[:i]
+		if e == "" || len(e) > 9 {
+			return 0, "", "", false
+		}
+		for j := 0; j < len(e); j++ {
+			if e[j] < '0' || e[j] > '9' {
+				return 0, "", "", false
+			}
+			epoch = epoch*10 + int(e[j]-'0')
+		}
+		version = version[i+1:]
+	}
+
+	if i := strings.LastIndexByte(version, '-'); i >= 0 {
+		version, release = version[:i], version[i+1:]
+		if release == "" {
+			return 0, "", "", false
+		}
+	}
+
+	if version == "" {
+		return 0, "", "", false
+	}
+
+	return epoch, version, release, true
+}
+
+// toSemver converts a distro version (with '~' marking a prerelease) and
+// release into Semver: "1.2.3~rc1" + "2" -> "1.2.3-rc1+2".
+func toSemver(epoch int, version, release string) (semver.Semver, bool) {
+	if epoch != 0 {
+		return semver.Semver{Original: version, Valid: false}, false
+	}
+
+	s := strings.Replace(version, "~", "-", 1)
+	if release != "" {
+		if strings.IndexByte(s, '+') >= 0 {
+			s += "." + release
+		} else {
+			s += "+" + release
+		}
+	}
+
+	v, ok := semver.Parse(strings.NewReplacer("~", ".", "^", ".", "_", "-").Replace(s))
+	if !ok || v.HasV() {
+		return semver.Semver{Original: s, Valid: false}, false
+	}
+
+	return v, true
+}
+
+// sign normalizes an integer difference to -1, 0 or +1.
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+
+	return 0
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// isAlpha reports whether c is an ASCII letter.
+func isAlpha(c byte) bool {
+	return 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z'
+}
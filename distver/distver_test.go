@@ -0,0 +1,112 @@
+package distver
+
+import "testing"
+
+// TestCompareDeb covers dpkg ordering rules including '~' and epochs.
+func TestCompareDeb(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.0~rc1", "1.0", -1},
+		{"1.0~~", "1.0~", -1},
+		{"1.0~rc1", "1.0~rc2", -1},
+		{"1.0", "1.0a", -1},
+		{"1.0a", "1.0+", -1},
+		{"1.0-1", "1.0-2", -1},
+		{"1.0-10", "1.0-9", 1},
+		{"1:0.9", "2.0", 1},
+		{"1.01", "1.1", 0},
+		{"2.0-1ubuntu1", "2.0-1", 1},
+		{"bad", "1.0", -1},
+	}
+
+	for _, tt := range tests {
+		if got := CompareDeb(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareDeb(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := CompareDeb(tt.b, tt.a); got != -tt.want {
+			t.Errorf("CompareDeb(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+	}
+}
+
+// TestCompareRPM covers rpmvercmp ordering rules including '~' and '^'.
+func TestCompareRPM(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.0", "1.0.1", -1},
+		{"1.0~rc1", "1.0", -1},
+		{"1.0^git1", "1.0", 1},
+		{"1.0^git1", "1.0.1", -1},
+		{"1.0a", "1.0", 1},
+		{"1.a", "1.1", -1},
+		{"1.010", "1.9", 1},
+		{"1.0-1.el9", "1.0-2.el9", -1},
+		{"1.0-1", "1.0", 0},
+		{"1:1.0", "2.0", 1},
+		{"1.0_1", "1.0.1", 0},
+	}
+
+	for _, tt := range tests {
+		if got := CompareRPM(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareRPM(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := CompareRPM(tt.b, tt.a); got != -tt.want {
+			t.Errorf("CompareRPM(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+	}
+}
+
+// TestSemver checks conversion of distro versions into Semver.
+func TestSemver(t *testing.T) {
+	tests := []struct {
+		in, want string
+		rpm      bool
+	}{
+		{"1.2.3", "1.2.3", false},
+		{"1.2.3~rc1-2", "1.2.3-rc1+2", false},
+		{"1:1.2.3", "", false},
+		{"1.2.3.4", "", false},
+		{"1.2.3~rc1-2.el9", "1.2.3-rc1+2.el9", true},
+		{"1.2.3^git1", "", true},
+	}
+
+	for _, tt := range tests {
+		var got string
+		var ok bool
+		if tt.rpm {
+			r, _ := ParseRPM(tt.in)
+			v, vok := r.Semver()
+			got, ok = v.SemVer(), vok
+		} else {
+			d, _ := ParseDeb(tt.in)
+			v, vok := d.Semver()
+			got, ok = v.SemVer(), vok
+		}
+
+		if ok != (tt.want != "") || ok && got != tt.want {
+			t.Errorf("Semver(%q) = %q ok=%v, want %q", tt.in, got, ok, tt.want)
+		}
+	}
+}
+
+// TestString checks rendering round trips.
+func TestString(t *testing.T) {
+	for _, s := range []string{"1.0", "2:1.0-1ubuntu1", "1.0~rc1-0.1"} {
+		d, ok := ParseDeb(s)
+		if !ok || d.String() != s {
+			t.Errorf("ParseDeb(%q).String() = %q", s, d.String())
+		}
+	}
+	for _, s := range []string{"1.0", "3:1.0-1.el9"} {
+		r, ok := ParseRPM(s)
+		if !ok || r.String() != s {
+			t.Errorf("ParseRPM(%q).String() = %q", s, r.String())
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package distver
+
+import (
+	"strings"
+
+	"github.com/woozymasta/semver"
+)
+
+// RPM is an RPM package version "[EPOCH:]VERSION[-RELEASE]".
+type RPM struct {
+	// Version the upstream version
+	Version string
+
+	// Release the package release, may be empty
+	Release string
+
+	// Epoch numeric epoch, 0 if absent
+	Epoch int
+}
+
+// ParseRPM parses an RPM EVR string. Version and release must not be empty
+// and may not contain '-' (the release starts after the last one).
+func ParseRPM(s string) (RPM, bool) {
+	epoch, ver, rel, ok := splitEVR(s)
+	if !ok || strings.IndexByte(ver, '-') >= 0 {
+		return RPM{}, false
+	}
+
+	return RPM{Epoch: epoch, Version: ver, Release: rel}, true
+}
+
+// String renders the version in "[EPOCH:]VERSION[-RELEASE]" form.
+func (r RPM) String() string {
+	s := r.Version
+	if r.Epoch != 0 {
+		s = itoa(r.Epoch) + ":" + s
+	}
+	if r.Release != "" {
+		s += "-" + r.Release
+	}
+
+	return s
+}
+
+// Compare compares r with o using rpmvercmp: epoch numerically, then version
+// and release segment by segment; '~' sorts before everything and '^' after
+// the end of the string. Releases are only compared when both are present.
+// Returns -1, 0 or +1.
+func (r RPM) Compare(o RPM) int {
+	if r.Epoch != o.Epoch {
+		return sign(r.Epoch - o.Epoch)
+	}
+	if c := rpmCompare(r.Version, o.Version); c != 0 {
+		return c
+	}
+	if r.Release == "" || o.Release == "" {
+		return 0
+	}
+
+	return rpmCompare(r.Release, o.Release)
+}
+
+// Semver converts r to Semver: "1.2.3~rc1-2.el9" -> "1.2.3-rc1+2.el9".
+// Returns false for non-zero epochs or versions that are not semver.
+func (r RPM) Semver() (semver.Semver, bool) {
+	return toSemver(r.Epoch, r.Version, r.Release)
+}
+
+// CompareRPM parses and compares two RPM versions.
+// Invalid versions sort before valid ones.
+func CompareRPM(a, b string) int {
+	ra, okA := ParseRPM(a)
+	rb, okB := ParseRPM(b)
+	switch {
+	case !okA && !okB:
+		return 0
+	case !okA:
+		return -1
+	case !okB:
+		return 1
+	}
+
+	return ra.Compare(rb)
+}
+
+// rpmCompare is rpm's rpmvercmp.
+func rpmCompare(a, b string) int {
+	if a == b {
+		return 0
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		// skip separators
+		for i < len(a) && !isDigit(a[i]) && !isAlpha(a[i]) && a[i] != '~' && a[i] != '^' {
+			i++
+		}
+		for j < len(b) && !isDigit(b[j]) && !isAlpha(b[j]) && b[j] != '~' && b[j] != '^' {
+			j++
+		}
+
+		// tilde sorts before everything
+		if i < len(a) && a[i] == '~' || j < len(b) && b[j] == '~' {
+			if i >= len(a) || a[i] != '~' {
+				return 1
+			}
+			if j >= len(b) || b[j] != '~' {
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+
+		// caret sorts after the end but before anything else
+		if i < len(a) && a[i] == '^' || j < len(b) && b[j] == '^' {
+			if i >= len(a) {
+				return -1
+			}
+			if j >= len(b) {
+				return 1
+			}
+			if a[i] != '^' {
+				return 1
+			}
+			if b[j] != '^' {
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+
+		if i >= len(a) || j >= len(b) {
+			break
+		}
+
+		// segment of the same class
+		si, sj := i, j
+		isNum := isDigit(a[i])
+		if isNum {
+			for i < len(a) && isDigit(a[i]) {
+				i++
+			}
+			for j < len(b) && isDigit(b[j]) {
+				j++
+			}
+		} else {
+			for i < len(a) && isAlpha(a[i]) {
+				i++
+			}
+			for j < len(b) && isAlpha(b[j]) {
+				j++
+			}
+		}
+
+		// b segment is of the other class: numeric wins
+		if sj == j {
+			if isNum {
+				return 1
+			}
+			return -1
+		}
+
+		x, y := a[si:i], b[sj:j]
+		if isNum {
+			x = strings.TrimLeft(x, "0")
+			y = strings.TrimLeft(y, "0")
+			if len(x) != len(y) {
+				return sign(len(x) - len(y))
+			}
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case i >= len(a) && j >= len(b):
+		return 0
+	case i < len(a):
+		return 1
+	}
+
+	return -1
+}
@@ -14,9 +14,14 @@ strings (SemVer 2.0.0) with a few pragmatic deviations:
     tie-breaker on the original input to produce stable order.
   - Numeric components must fit into the host int size; overly large
     numbers are rejected as invalid.
+  - ParseWith accepts opt-in non-SemVer forms (ParseOptions): a fourth
+    "revision" component ("1.2.3.4") and distro-style epochs ("2:1.4.0").
 
 Canonical form returned by Semver.Canonical() (and String()) always uses the
 "v" prefix and strips build metadata: "vMAJOR.MINOR.PATCH[-PRERELEASE]".
+It also drops the epoch and revision of ParseWith versions, which still take
+part in comparison, so for those it is not a precedence identity; use Key,
+or CompareWith with IgnoreRevision.
 
 # Quick examples
 
@@ -0,0 +1,25 @@
+package semver
+
+import "errors"
+
+// Errors returned by Registry implementations, Resolve, ResolveQuery,
+// VersionGuard, ConstraintFromRegex, ConstraintFromOSV, VersionMap, Eval and
+// flag values.
+var (
+	ErrInvalidVersion    = errors.New("semver: invalid version")
+	ErrInvalidConstraint = errors.New("semver: invalid constraint")
+	ErrVersionExists     = errors.New("semver: version already published")
+	ErrVersionNotFound   = errors.New("semver: version not found")
+	ErrNoMatch           = errors.New("semver: no version satisfies constraint")
+	ErrInvalidQuery      = errors.New("semver: invalid version query")
+	ErrVersionRegression = errors.New("semver: version regression")
+	ErrNotExpressible    = errors.New("semver: regex not expressible as constraint")
+	ErrInconsistent      = errors.New("semver: inconsistent module versions")
+	ErrInvalidExpression = errors.New("semver: invalid version expression")
+)
+
+// Errors returned by UnmarshalBinary.
+var (
+	ErrBinaryVersion   = errors.New("semver: unsupported binary encoding version")
+	ErrBinaryMalformed = errors.New("semver: malformed binary encoding")
+)
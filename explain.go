@@ -0,0 +1,122 @@
+package semver
+
+import "strings"
+
+// Explanation describes why a version does or does not satisfy a
+// Constraint, see Constraint.Explain.
+type Explanation struct {
+	// Version is the explained version.
+	Version Semver
+
+	// Term is the source text of the matching "||" alternative for satisfied
+	// versions, or of the range term the failing Bound was desugared from
+	// ("~1.3"). Empty for invalid versions.
+	Term string
+
+	// Bound is the primitive comparator v failed ("<1.4.0-0"),
+	// empty if Satisfied.
+	Bound string
+
+	// Kind names the failing Bound: "lower bound", "upper bound", "exact
+	// match" or "exclusion", or is "prerelease" for a version rejected by
+	// the npm prerelease rule (see Check). Empty if Satisfied.
+	Kind string
+
+	// Satisfied reports whether Version satisfies the constraint.
+	Satisfied bool
+}
+
+// String renders e for error messages:
+//
+//	1.3.5 satisfies '~1.3'
+//	1.4.0 rejected: upper bound <1.4.0-0 from '~1.3'
+func (e Explanation) String() string {
+	switch {
+	case e.Satisfied:
+		return e.Version.Original + " satisfies '" + e.Term + "'"
+	case !e.Version.Valid:
+		return e.Version.Original + " rejected: invalid version"
+	case e.Kind == "prerelease":
+		return e.Version.Original + " rejected: prerelease not allowed by '" + e.Term + "'"
+	case e.Bound == "":
+		return e.Version.Original + " rejected: empty constraint"
+	}
+
+	return e.Version.Original + " rejected: " + e.Kind + " " + e.Bound + " from '" + e.Term + "'"
+}
+
+// Explain reports which "||" alternative v satisfies or, if none, which
+// comparator rejected it. Of several alternatives the one v misses by the
+// fewest comparators is reported (the first on ties), of its failing
+// comparators the first one.
+func (c Constraint) Explain(v Semver) Explanation {
+	e := Explanation{Version: v}
+	if !v.Valid {
+		return e
+	}
+
+	best := -1
+	for _, group := range c.groups {
+		var first *comparator
+		failed := 0
+		for i := range group {
+			if !group[i].check(v) {
+				if first == nil {
+					first = &group[i]
+				}
+				failed++
+			}
+		}
+
+		if failed == 0 && !allowsPrerelease(group, v) {
+			if best < 0 || 1 < best {
+				best = 1
+				e.Term, e.Bound, e.Kind = groupTerm(group), "", "prerelease"
+			}
+			continue
+		}
+		if failed == 0 {
+			e.Satisfied = true
+			e.Term = groupTerm(group)
+			e.Bound, e.Kind = "", ""
+			return e
+		}
+		if best < 0 || failed < best {
+			best = failed
+			e.Term = first.term
+			e.Bound = first.op.String() + first.v.Print(PrintPrefixNoV|PrintMaskRelease|PrintPrerelease)
+			e.Kind = first.op.kind()
+		}
+	}
+
+	return e
+}
+
+// kind names the bound op represents in explanations.
+func (op operator) kind() string {
+	switch op {
+	case opGT, opGE:
+		return "lower bound"
+	case opLT, opLE:
+		return "upper bound"
+	case opNE:
+		return "exclusion"
+	}
+
+	return "exact match"
+}
+
+// groupTerm joins the distinct source terms of group ("*" if empty).
+func groupTerm(group []comparator) string {
+	terms := make([]string, 0, len(group))
+	for _, cmp := range group {
+		if len(terms) == 0 || terms[len(terms)-1] != cmp.term {
+			terms = append(terms, cmp.term)
+		}
+	}
+	if len(terms) == 0 {
+		return "*"
+	}
+
+	return strings.Join(terms, " ")
+}
@@ -0,0 +1,39 @@
+package semver
+
+import "testing"
+
+func TestConstraint_Explain(t *testing.T) {
+	tests := []struct {
+		c, v      string
+		want      string
+		satisfied bool
+	}{
+		{"~1.3", "1.3.5", "1.3.5 satisfies '~1.3'", true},
+		{"~1.3", "1.4.0", "1.4.0 rejected: upper bound <1.4.0-0 from '~1.3'", false},
+		{"~1.3", "1.2.9", "1.2.9 rejected: lower bound >=1.3.0 from '~1.3'", false},
+		{">=1.0.0, <2.0.0 || ^3", "v3.1.0", "v3.1.0 satisfies '^3'", true},
+		{">=1.0.0, <2.0.0 || ^3", "1.5.0", "1.5.0 satisfies '>=1.0.0 <2.0.0'", true},
+		{">=1.0.0 <2.0.0 || ^3", "2.1.0", "2.1.0 rejected: upper bound <2.0.0 from '<2.0.0'", false},
+		{"^1.2 !=1.4.0", "1.4.0", "1.4.0 rejected: exclusion !=1.4.0 from '!=1.4.0'", false},
+		{"1.2.3", "1.2.4", "1.2.4 rejected: exact match =1.2.3 from '1.2.3'", false},
+		{"*", "0.1.0", "0.1.0 satisfies '*'", true},
+		{"^1.0.0", "1.5.0-alpha", "1.5.0-alpha rejected: prerelease not allowed by '^1.0.0'", false},
+		{">=1.5.0-beta <2", "1.5.0-rc.1", "1.5.0-rc.1 satisfies '>=1.5.0-beta <2'", true},
+		{"^1", "bad", "bad rejected: invalid version", false},
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.v)
+		e := MustConstraint(tt.c).Explain(v)
+		if got := e.String(); got != tt.want {
+			t.Errorf("Explain(%q, %q) = %q, want %q", tt.c, tt.v, got, tt.want)
+		}
+		if e.Satisfied != tt.satisfied || e.Satisfied != MustConstraint(tt.c).Check(v) {
+			t.Errorf("Explain(%q, %q).Satisfied = %v, want %v", tt.c, tt.v, e.Satisfied, tt.satisfied)
+		}
+	}
+
+	if got := (Constraint{}).Explain(MustParse("1.0.0")).String(); got != "1.0.0 rejected: empty constraint" {
+		t.Errorf("zero Constraint Explain = %q", got)
+	}
+}
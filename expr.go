@@ -0,0 +1,246 @@
+package semver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Expr is a parsed version expression, see ParseExpr.
+type Expr struct {
+	root exprNode
+	src  string
+	vars []string
+}
+
+// exprNode is a node of an Expr syntax tree.
+type exprNode interface {
+	eval(vars map[string]Semver) bool
+}
+
+type (
+	exprOr  struct{ l, r exprNode }
+	exprAnd struct{ l, r exprNode }
+	exprNot struct{ x exprNode }
+	exprCmp struct {
+		c    Constraint
+		name string
+		not  bool // "!=": the version is valid and outside c
+	}
+)
+
+func (e exprOr) eval(vars map[string]Semver) bool  { return e.l.eval(vars) || e.r.eval(vars) }
+func (e exprAnd) eval(vars map[string]Semver) bool { return e.l.eval(vars) && e.r.eval(vars) }
+func (e exprNot) eval(vars map[string]Semver) bool { return !e.x.eval(vars) }
+
+func (e exprCmp) eval(vars map[string]Semver) bool {
+	v := vars[e.name]
+	if !v.Valid {
+		return false
+	}
+
+	return e.c.CheckWith(v, CheckOptions{IncludePrerelease: true}) != e.not
+}
+
+// exprOps are the comparison operators of ParseExpr, longest first,
+// with the constraint operator they map to; "!=" is the negated "=" so
+// that it accepts partial versions too.
+var exprOps = [...][2]string{
+	{"==", "="}, {"!=", "!"}, {">=", ">="}, {"<=", "<="},
+	{"=", "="}, {">", ">"}, {"<", "<"}, {"~", "~"}, {"^", "^"},
+}
+
+// ParseExpr parses a boolean expression over named versions:
+//
+//	app >= 1.4 && (db ~ 2.3 || db >= 3)
+//
+// A comparison is a variable name, an operator and a (partial) version as
+// accepted by ParseConstraint: ==, =, !=, >, >=, <, <= compare, ~ and ^
+// are tilde and caret ranges ("db ~ 2.3" is ">=2.3.0 <2.4.0-0"), and
+// x-ranges such as "1.x" work with any of them ("app != 1.5" holds outside
+// 1.5.x). Comparisons of an invalid version are false, "!=" included.
+// Comparisons combine with !, && and || (in order of precedence) and
+// parentheses.
+//
+// Names start with a letter or '_' and may contain letters, digits and
+// "_-./". Malformed expressions fail with an error wrapping
+// ErrInvalidExpression.
+func ParseExpr(s string) (*Expr, error) {
+	p := exprParser{src: s}
+	root, err := p.or()
+	if err == nil && p.skip() < len(s) {
+		err = p.errorf("unexpected %q", s[p.pos:])
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(p.vars)
+	return &Expr{root: root, src: s, vars: slices.Compact(p.vars)}, nil
+}
+
+// String returns the source of e.
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Vars returns the sorted variable names referenced by e.
+func (e *Expr) Vars() []string {
+	return slices.Clone(e.vars)
+}
+
+// Eval evaluates e with the versions in vars, checking comparisons with
+// CheckOptions.IncludePrerelease. An invalid version satisfies nothing.
+// Every referenced variable must be present in vars, even in branches
+// short-circuiting skips, else Eval fails with an error wrapping
+// ErrInvalidExpression.
+func (e *Expr) Eval(vars map[string]Semver) (bool, error) {
+	for _, name := range e.vars {
+		if _, ok := vars[name]; !ok {
+			return false, fmt.Errorf("%w: undefined variable %q in %q", ErrInvalidExpression, name, e.src)
+		}
+	}
+
+	return e.root.eval(vars), nil
+}
+
+// Eval parses expr with ParseExpr and evaluates it with vars.
+func Eval(expr string, vars map[string]Semver) (bool, error) {
+	e, err := ParseExpr(expr)
+	if err != nil {
+		return false, err
+	}
+
+	return e.Eval(vars)
+}
+
+// exprParser is a recursive descent parser for ParseExpr.
+type exprParser struct {
+	src  string
+	vars []string
+	pos  int
+}
+
+// skip advances past whitespace and returns the position.
+func (p *exprParser) skip() int {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t' || p.src[p.pos] == '\n') {
+		p.pos++
+	}
+
+	return p.pos
+}
+
+// accept consumes tok if it comes next.
+func (p *exprParser) accept(tok string) bool {
+	p.skip()
+	if strings.HasPrefix(p.src[p.pos:], tok) {
+		p.pos += len(tok)
+		return true
+	}
+
+	return false
+}
+
+// errorf returns a syntax error at the current position.
+func (p *exprParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %q at offset %d: %s", ErrInvalidExpression, p.src, p.pos, fmt.Sprintf(format, args...))
+}
+
+// or := and { "||" and }
+func (p *exprParser) or() (exprNode, error) {
+	l, err := p.and()
+	for err == nil && p.accept("||") {
+		var r exprNode
+		if r, err = p.and(); err == nil {
+			l = exprOr{l, r}
+		}
+	}
+
+	return l, err
+}
+
+// and := unary { "&&" unary }
+func (p *exprParser) and() (exprNode, error) {
+	l, err := p.unary()
+	for err == nil && p.accept("&&") {
+		var r exprNode
+		if r, err = p.unary(); err == nil {
+			l = exprAnd{l, r}
+		}
+	}
+
+	return l, err
+}
+
+// unary := "!" unary | "(" or ")" | cmp
+func (p *exprParser) unary() (exprNode, error) {
+	switch {
+	case p.accept("!"):
+		x, err := p.unary()
+		return exprNot{x}, err
+
+	case p.accept("("):
+		x, err := p.or()
+		if err == nil && !p.accept(")") {
+			err = p.errorf("missing ')'")
+		}
+		return x, err
+	}
+
+	return p.cmp()
+}
+
+// cmp := name op version
+func (p *exprParser) cmp() (exprNode, error) {
+	start := p.skip()
+	for p.pos < len(p.src) && isExprNameByte(p.src[p.pos], p.pos == start) {
+		p.pos++
+	}
+	name := p.src[start:p.pos]
+	if name == "" {
+		return nil, p.errorf("want a variable name")
+	}
+
+	op := ""
+	for _, o := range exprOps {
+		if p.accept(o[0]) {
+			op = o[1]
+			break
+		}
+	}
+	if op == "" {
+		return nil, p.errorf("want an operator after %q", name)
+	}
+
+	vstart := p.skip()
+	for p.pos < len(p.src) && !strings.ContainsRune(" \t\n()&|!", rune(p.src[p.pos])) {
+		p.pos++
+	}
+	ver := p.src[vstart:p.pos]
+
+	not := op == "!"
+	if not {
+		op = "="
+	}
+
+	c, ok := ParseConstraint(op + ver)
+	if !ok || ver == "" {
+		p.pos = vstart
+		return nil, p.errorf("invalid version %q", ver)
+	}
+
+	p.vars = append(p.vars, name)
+	return exprCmp{c: c, name: name, not: not}, nil
+}
+
+// isExprNameByte reports whether c may appear in a variable name.
+func isExprNameByte(c byte, first bool) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+		return true
+	case first:
+		return false
+	}
+
+	return isDigit(c) || c == '-' || c == '.' || c == '/'
+}
@@ -0,0 +1,93 @@
+package semver
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestEval(t *testing.T) {
+	vars := map[string]Semver{
+		"app":    MustParse("v1.4.2"),
+		"db":     MustParse("2.3.7"),
+		"next":   MustParse("2.0.0-rc.1"),
+		"my-svc": MustParse("0.9.0"),
+		"broken": {Original: "bogus"},
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"app >= 1.4", true},
+		{"app>=1.5", false},
+		{"app == 1.4.2", true},
+		{"app = v1.4.2", true},
+		{"app != 1.4.2", false},
+		{"app != 1.4.3", true},
+		{"app != 1.5", true},
+		{"app != 1.4", false},
+		{"app != 1.x", false},
+		{"db != 1.x", true},
+		{"next != 2", true},
+		{"app < 2", true},
+		{"app <= 1.4.1", false},
+		{"app > 1.4.1", true},
+		{"db ~ 2.3", true},
+		{"db ~ 2.4", false},
+		{"db ^ 2", true},
+		{"app == 1.x", true},
+		{"app >= 1.4 && (db ~ 2.3 || db >= 3)", true},
+		{"app >= 1.4 && (db ~ 2.4 || db >= 3)", false},
+		{"app >= 2 || db >= 2 && my-svc < 1", true},
+		{"(app >= 2 || db >= 2) && my-svc >= 1", false},
+		{"!(app >= 2)", true},
+		{"!app >= 1 || db ~ 2.3", true},
+		{"next >= 1.4", true},
+		{"next >= 2", false},
+		{"broken >= 0", false},
+		{"broken < 1", false},
+		{"broken != 1", false},
+	}
+
+	for _, tt := range tests {
+		got, err := Eval(tt.expr, vars)
+		if err != nil || got != tt.want {
+			t.Errorf("Eval(%q) = %v, %v, want %v", tt.expr, got, err, tt.want)
+		}
+	}
+}
+
+func TestEval_Errors(t *testing.T) {
+	vars := map[string]Semver{"app": MustParse("1.0.0")}
+
+	for _, expr := range []string{
+		"",
+		"app",
+		"app >=",
+		"app >= bogus",
+		"app >= 1 &&",
+		"(app >= 1",
+		"app >= 1)",
+		"app >= 1 & app < 2",
+		"1app >= 1",
+		"app >= 1 || missing >= 1",
+	} {
+		if got, err := Eval(expr, vars); !errors.Is(err, ErrInvalidExpression) {
+			t.Errorf("Eval(%q) = %v, %v, want ErrInvalidExpression", expr, got, err)
+		}
+	}
+}
+
+func TestParseExpr(t *testing.T) {
+	e, err := ParseExpr("db ~ 2.3 || app >= 1 && db < 4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := e.Vars(); !slices.Equal(got, []string{"app", "db"}) {
+		t.Errorf("Vars = %v", got)
+	}
+	if e.String() != "db ~ 2.3 || app >= 1 && db < 4" {
+		t.Errorf("String = %q", e.String())
+	}
+}
@@ -1,17 +1,24 @@
 package semver
 
+import "strings"
+
 // Flags is a compact bitmask describing which components were explicitly
 // present in the input (e.g., MINOR/PATCH for shorthand detection).
+//
+// All eight bits are in use. A further flag requires widening the type,
+// which also changes the MarshalBinary layout (a new layout version).
 type Flags uint8
 
 // Flags represent bitwise flags for Semver parsing state.
 const (
-	FlagHasV     Flags = 1 << iota // input had leading 'v'/'V'
-	FlagHasMajor                   // major component explicitly present (always true for valid)
-	FlagHasMinor                   // minor explicitly present in input
-	FlagHasPatch                   // patch explicitly present in input
-	FlagHasPre                     // prerelease present
-	FlagHasBuild                   // build metadata present
+	FlagHasV        Flags = 1 << iota // input had leading 'v'/'V'
+	FlagHasMajor                      // major component explicitly present (always true for valid)
+	FlagHasMinor                      // minor explicitly present in input
+	FlagHasPatch                      // patch explicitly present in input
+	FlagHasPre                        // prerelease present
+	FlagHasBuild                      // build metadata present
+	FlagHasRevision                   // fourth numeric component present (ParseWith)
+	FlagHasEpoch                      // "EPOCH:" prefix present (ParseWith)
 )
 
 // HasV reports whether the input had a leading 'v' or 'V'.
@@ -53,3 +60,33 @@ func (v Semver) HasPre() bool {
 func (v Semver) HasBuild() bool {
 	return v.Valid && v.Flags&FlagHasBuild != 0
 }
+
+// HasRevision reports whether a fourth numeric component was parsed.
+func (v Semver) HasRevision() bool {
+	return v.Valid && v.Flags&FlagHasRevision != 0
+}
+
+// HasEpoch reports whether an "EPOCH:" prefix was parsed.
+func (v Semver) HasEpoch() bool {
+	return v.Valid && v.Flags&FlagHasEpoch != 0
+}
+
+// prefixByte returns the 'v' or 'V' prefix of Original (after an epoch),
+// or 0 if there is none. Falls back to 'v' if FlagHasV is set but Original
+// was dropped (see Compact).
+func (v Semver) prefixByte() byte {
+	s := v.Original
+	if v.Flags&FlagHasEpoch != 0 {
+		if i := strings.IndexByte(s, ':'); i >= 0 {
+			s = s[i+1:]
+		}
+	}
+	if len(s) > 0 && (s[0] == 'v' || s[0] == 'V') {
+		return s[0]
+	}
+	if v.Original == "" && v.Flags&FlagHasV != 0 {
+		return 'v'
+	}
+
+	return 0
+}
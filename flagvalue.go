@@ -0,0 +1,54 @@
+package semver
+
+import (
+	"flag"
+	"fmt"
+)
+
+// Set implements flag.Value: it parses s into v.
+// Invalid input leaves v unchanged and returns an error wrapping ErrInvalidVersion.
+func (v *Semver) Set(s string) error {
+	nv, ok := Parse(s)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrInvalidVersion, s)
+	}
+
+	*v = nv
+	return nil
+}
+
+// Set implements flag.Value: it parses s into c.
+// Invalid input leaves c unchanged and returns an error wrapping ErrInvalidConstraint.
+func (c *Constraint) Set(s string) error {
+	nc, ok := ParseConstraint(s)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrInvalidConstraint, s)
+	}
+
+	*c = nc
+	return nil
+}
+
+// VersionVar defines a version flag with the specified name, default value and
+// usage string on fs (flag.CommandLine if nil). The argument p points to a
+// Semver variable in which to store the parsed value of the flag.
+func VersionVar(fs *flag.FlagSet, p *Semver, name string, value Semver, usage string) {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+
+	*p = value
+	fs.Var(p, name, usage)
+}
+
+// ConstraintVar defines a constraint flag with the specified name, default
+// value and usage string on fs (flag.CommandLine if nil). The argument p points
+// to a Constraint variable in which to store the parsed value of the flag.
+func ConstraintVar(fs *flag.FlagSet, p *Constraint, name string, value Constraint, usage string) {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+
+	*p = value
+	fs.Var(p, name, usage)
+}
@@ -0,0 +1,42 @@
+package semver
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"testing"
+)
+
+// TestFlagValue checks flag parsing of versions and constraints.
+func TestFlagValue(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	var minVersion Semver
+	var want Constraint
+	VersionVar(fs, &minVersion, "min-version", MustParse("1.0.0"), "minimal version")
+	ConstraintVar(fs, &want, "want", MustConstraint("*"), "accepted versions")
+
+	if err := fs.Parse([]string{"-min-version", "v1.4.0-rc.1", "-want", "^1.2"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if minVersion.Canonical() != "v1.4.0-rc.1" {
+		t.Errorf("min-version = %q", minVersion.Canonical())
+	}
+	if want.String() != "^1.2" || !want.Check(MustParse("1.3.0")) {
+		t.Errorf("want = %q", want.String())
+	}
+	if got := fs.Lookup("min-version").Value.String(); got != "v1.4.0-rc.1" {
+		t.Errorf("flag String() = %q", got)
+	}
+
+	if err := minVersion.Set("1.x"); !errors.Is(err, ErrInvalidVersion) {
+		t.Errorf("Set(1.x) err = %v, want ErrInvalidVersion", err)
+	}
+	if minVersion.Canonical() != "v1.4.0-rc.1" {
+		t.Errorf("failed Set modified value: %q", minVersion.Canonical())
+	}
+	if err := want.Set(">="); !errors.Is(err, ErrInvalidConstraint) {
+		t.Errorf("Set(>=) err = %v, want ErrInvalidConstraint", err)
+	}
+}
@@ -0,0 +1,70 @@
+package semver
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Gate maps version constraints to the features they enable, so capability
+// checks against a peer's reported version are declarative:
+//
+//	var peerFeatures = semver.Gate{
+//		">=1.4.0": "newProto",
+//		">=2.0.0": "v2Auth",
+//	}
+//
+//	if peerFeatures.Enabled(peer, "newProto") { ... }
+//
+// A feature listed under several constraints is enabled if any of them is
+// satisfied. Constraints are checked with CheckOptions.IncludePrerelease.
+// Constraints that fail to parse never enable anything; call Validate in a
+// test to catch them.
+type Gate map[string]string
+
+// Enabled reports whether feature is enabled for v.
+func (g Gate) Enabled(v Semver, feature string) bool {
+	for expr, f := range g {
+		if f == feature && gateCheck(expr, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Features returns the features enabled for v, sorted and deduplicated.
+func (g Gate) Features(v Semver) []string {
+	var out []string
+	for expr, f := range g {
+		if gateCheck(expr, v) {
+			out = append(out, f)
+		}
+	}
+	slices.Sort(out)
+
+	return slices.Compact(out)
+}
+
+// Validate reports the first constraint of g that fails to parse,
+// wrapping ErrInvalidConstraint.
+func (g Gate) Validate() error {
+	exprs := make([]string, 0, len(g))
+	for expr := range g {
+		exprs = append(exprs, expr)
+	}
+	slices.Sort(exprs)
+
+	for _, expr := range exprs {
+		if _, ok := ParseConstraint(expr); !ok {
+			return fmt.Errorf("%w: %q (feature %q)", ErrInvalidConstraint, expr, g[expr])
+		}
+	}
+
+	return nil
+}
+
+// gateCheck reports whether v satisfies expr by precedence.
+func gateCheck(expr string, v Semver) bool {
+	c, ok := ParseConstraint(expr)
+	return ok && c.CheckWith(v, CheckOptions{IncludePrerelease: true})
+}
@@ -0,0 +1,53 @@
+package semver
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestGate(t *testing.T) {
+	g := Gate{
+		">=1.4.0":          "newProto",
+		">=2.0.0":          "v2Auth",
+		"~1.2":             "legacyCodec",
+		">=3.0.0 || 1.9.x": "legacyCodec",
+	}
+	if err := g.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		v    string
+		want []string
+	}{
+		{"1.0.0", nil},
+		{"1.2.5", []string{"legacyCodec"}},
+		{"1.4.0", []string{"newProto"}},
+		{"1.9.1", []string{"legacyCodec", "newProto"}},
+		{"2.0.0-rc.1", []string{"newProto"}},
+		{"2.0.0", []string{"newProto", "v2Auth"}},
+		{"3.1.0", []string{"legacyCodec", "newProto", "v2Auth"}},
+		{"bad", nil},
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.v)
+		if got := g.Features(v); !slices.Equal(got, tt.want) {
+			t.Errorf("Features(%q) = %q, want %q", tt.v, got, tt.want)
+		}
+		for _, f := range []string{"newProto", "v2Auth", "legacyCodec", "unknown"} {
+			if got, want := g.Enabled(v, f), slices.Contains(tt.want, f); got != want {
+				t.Errorf("Enabled(%q, %q) = %v, want %v", tt.v, f, got, want)
+			}
+		}
+	}
+
+	bad := Gate{">=1.0.0": "a", ">=bad": "b"}
+	if err := bad.Validate(); !errors.Is(err, ErrInvalidConstraint) {
+		t.Errorf("Validate = %v, want ErrInvalidConstraint", err)
+	}
+	if bad.Enabled(MustParse("5.0.0"), "b") {
+		t.Error("invalid constraint enabled a feature")
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Package gitsource reads release versions from git tags.
+
+Tags come either from a local repository (Repo runs `git tag --list`) or
+from `git ls-remote --tags` output fed as an io.Reader (ParseLsRemote), so
+release scripts get a semver.List without shelling out and re-parsing.
+Tags are matched with semver.ParsePrefixed; only tags with the configured
+prefix ("" for plain "v1.2.3" tags, "cmd/tool/" for monorepo modules) are kept.
+*/
+package gitsource
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/woozymasta/semver"
+)
+
+// Repo is a local git repository.
+type Repo struct {
+	// Dir is the repository directory; empty means the current directory.
+	Dir string
+
+	// Git is the git executable; empty means "git" from PATH.
+	Git string
+}
+
+// Tags returns all tag names of the repository.
+func (r Repo) Tags(ctx context.Context) ([]string, error) {
+	git := r.Git
+	if git == "" {
+		git = "git"
+	}
+
+	args := []string{"tag", "--list"}
+	if r.Dir != "" {
+		args = append([]string{"-C", r.Dir}, args...)
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, git, args...)
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("gitsource: git tag: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return strings.Fields(string(out)), nil
+}
+
+// Versions returns the versions of tags carrying prefix, in ascending order.
+func (r Repo) Versions(ctx context.Context, prefix string) (semver.List, error) {
+	tags, err := r.Tags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return versions(tags, prefix), nil
+}
+
+// ParseLsRemote reads `git ls-remote --tags` output ("<sha>\trefs/tags/<name>"
+// per line) and returns the tag names in input order. Peeled entries
+// ("<name>^{}") and refs outside refs/tags/ are skipped.
+func ParseLsRemote(r io.Reader) ([]string, error) {
+	var tags []string
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) != 2 {
+			continue
+		}
+
+		name, ok := strings.CutPrefix(fields[1], "refs/tags/")
+		if !ok || strings.HasSuffix(name, "^{}") {
+			continue
+		}
+		tags = append(tags, name)
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("gitsource: read ls-remote output: %w", err)
+	}
+
+	return tags, nil
+}
+
+// VersionsFromLsRemote is ParseLsRemote followed by prefix filtering,
+// returning the versions in ascending order.
+func VersionsFromLsRemote(r io.Reader, prefix string) (semver.List, error) {
+	tags, err := ParseLsRemote(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return versions(tags, prefix), nil
+}
+
+// LatestRelease returns the highest version of ls without a prerelease.
+// Returns (zero, false) if there is none.
+func LatestRelease(ls semver.List) (semver.Semver, bool) {
+	var best semver.Semver
+	for _, v := range ls {
+		if v.Valid && !v.HasPre() && (!best.Valid || v.IsGreater(best)) {
+			best = v
+		}
+	}
+
+	return best, best.Valid
+}
+
+// NextPatch returns the patch bump of LatestRelease(ls), rendered in the
+// style of that tag. Returns (zero, false) if ls has no release.
+func NextPatch(ls semver.List) (semver.Semver, bool) {
+	latest, ok := LatestRelease(ls)
+	if !ok {
+		return semver.Semver{}, false
+	}
+
+	return latest.BumpPatch()
+}
+
+// versions parses tags with prefix and sorts the result.
+func versions(tags []string, prefix string) semver.List {
+	ls := semver.ParseListPrefixed(tags, prefix)
+	ls.Sort()
+
+	return ls
+}
@@ -0,0 +1,102 @@
+package gitsource
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const lsRemote = `4b825dc642cb6eb9a060e54bf8d69288fbee4904	HEAD
+4b825dc642cb6eb9a060e54bf8d69288fbee4904	refs/heads/main
+1111111111111111111111111111111111111111	refs/tags/v1.0.0
+2222222222222222222222222222222222222222	refs/tags/v1.0.0^{}
+3333333333333333333333333333333333333333	refs/tags/v1.1.0
+4444444444444444444444444444444444444444	refs/tags/v2.0.0-rc.1
+5555555555555555555555555555555555555555	refs/tags/cmd/tool/v0.3.0
+6666666666666666666666666666666666666666	refs/tags/nightly
+`
+
+func TestParseLsRemote(t *testing.T) {
+	tags, err := ParseLsRemote(strings.NewReader(lsRemote))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "v1.0.0 v1.1.0 v2.0.0-rc.1 cmd/tool/v0.3.0 nightly"
+	if got := strings.Join(tags, " "); got != want {
+		t.Fatalf("ParseLsRemote = %q, want %q", got, want)
+	}
+}
+
+func TestVersionsFromLsRemote(t *testing.T) {
+	ls, err := VersionsFromLsRemote(strings.NewReader(lsRemote), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ls) != 3 || ls[2].Original != "v2.0.0-rc.1" {
+		t.Fatalf("versions = %v", ls)
+	}
+
+	latest, ok := LatestRelease(ls)
+	if !ok || latest.Original != "v1.1.0" {
+		t.Fatalf("LatestRelease = %q, %v", latest.Original, ok)
+	}
+
+	next, ok := NextPatch(ls)
+	if !ok || next.Original != "v1.1.1" {
+		t.Fatalf("NextPatch = %q, %v", next.Original, ok)
+	}
+
+	tool, _ := VersionsFromLsRemote(strings.NewReader(lsRemote), "cmd/tool/")
+	if len(tool) != 1 || tool[0].Original != "v0.3.0" {
+		t.Fatalf("prefixed versions = %v", tool)
+	}
+
+	if _, ok := NextPatch(nil); ok {
+		t.Fatalf("NextPatch(nil) succeeded")
+	}
+}
+
+func TestRepo_Versions(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+
+	dir := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+
+	git("init", "-q")
+	if err := os.WriteFile(filepath.Join(dir, "f"), []byte("x"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	git("add", "f")
+	git("commit", "-q", "-m", "init")
+	for _, tag := range []string{"v0.9.0", "v0.10.0", "release-candidate"} {
+		git("tag", tag)
+	}
+
+	ls, err := Repo{Dir: dir}.Versions(context.Background(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ls) != 2 || ls[1].Original != "v0.10.0" {
+		t.Fatalf("Versions = %v", ls)
+	}
+
+	if _, err := (Repo{Dir: filepath.Join(dir, "missing")}).Tags(context.Background()); err == nil {
+		t.Fatalf("Tags on missing repo succeeded")
+	}
+}
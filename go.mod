@@ -1,3 +1,3 @@
 module github.com/woozymasta/semver
 
-go 1.18.0
+go 1.21
@@ -0,0 +1,88 @@
+package semver
+
+import "strings"
+
+// IsIncompatible reports whether v carries the Go "+incompatible" build
+// suffix used for v2+ modules that do not have a go.mod major path suffix.
+func (v Semver) IsIncompatible() bool {
+	return v.Valid && v.Build == "incompatible"
+}
+
+// ModulePathSuffix returns the Go module path major suffix for v:
+// "" for v0/v1 (and "+incompatible" versions), "/vN" for N >= 2.
+// Empty for invalid versions.
+func (v Semver) ModulePathSuffix() string {
+	if !v.Valid || v.Major < 2 || v.IsIncompatible() {
+		return ""
+	}
+
+	var b strings.Builder
+	b.Grow(2 + digits10(v.Major))
+	b.WriteString("/v")
+	writeInt(&b, v.Major)
+
+	return b.String()
+}
+
+// IsGoModCompatible reports whether v may be a version of the Go module
+// modulePath according to major version suffix rules:
+//
+//   - "example.com/m" accepts v0, v1 and v2+ with "+incompatible";
+//   - "example.com/m/vN" accepts only vN (N >= 2), never "+incompatible";
+//   - "gopkg.in/m.vN" accepts only vN (N >= 0), never "+incompatible".
+func (v Semver) IsGoModCompatible(modulePath string) bool {
+	if !v.Valid {
+		return false
+	}
+
+	major, ok := splitPathMajor(modulePath)
+	if !ok {
+		return false
+	}
+
+	if major < 0 {
+		if v.IsIncompatible() {
+			return v.Major >= 2
+		}
+		return v.Major < 2
+	}
+
+	return !v.IsIncompatible() && v.Major == major
+}
+
+// splitPathMajor extracts the major version encoded in a module path suffix.
+// Returns major -1 when the path has no suffix; gopkg.in paths always carry
+// a ".vN" suffix. ok is false for malformed suffixes ("/v1", "/v02").
+func splitPathMajor(path string) (major int, ok bool) {
+	if strings.HasPrefix(path, "gopkg.in/") {
+		i := strings.LastIndex(path, ".v")
+		if i < 0 {
+			return 0, false
+		}
+
+		n, next, ok := parseInt(path, i+2)
+		if !ok || next != len(path) {
+			return 0, false
+		}
+		return n, true
+	}
+
+	i := strings.LastIndex(path, "/v")
+	if i < 0 || i+2 >= len(path) {
+		return -1, true
+	}
+
+	n, next, ok := parseInt(path, i+2)
+	if !ok || next != len(path) {
+		// last element merely starts with 'v' ("example.com/vendor")
+		if isNum(path[i+2:]) {
+			return 0, false
+		}
+		return -1, true
+	}
+	if n < 2 {
+		return 0, false
+	}
+
+	return n, true
+}
@@ -0,0 +1,53 @@
+package semver
+
+import "testing"
+
+// TestModulePathSuffix checks "/vN" suffix rendering.
+func TestModulePathSuffix(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"v0.3.0", ""},
+		{"v1.2.3", ""},
+		{"v2.0.0", "/v2"},
+		{"v12.1.0-rc.1", "/v12"},
+		{"v3.0.0+incompatible", ""},
+		{"bad", ""},
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.in)
+		if got := v.ModulePathSuffix(); got != tt.want {
+			t.Errorf("ModulePathSuffix(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+// TestIsGoModCompatible checks major suffix consistency rules.
+func TestIsGoModCompatible(t *testing.T) {
+	tests := []struct {
+		path, v string
+		want    bool
+	}{
+		{"example.com/m", "v1.2.3", true},
+		{"example.com/m", "v0.1.0", true},
+		{"example.com/m", "v2.0.0", false},
+		{"example.com/m", "v2.0.0+incompatible", true},
+		{"example.com/m", "v1.0.0+incompatible", false},
+		{"example.com/m/v2", "v2.1.0", true},
+		{"example.com/m/v2", "v3.0.0", false},
+		{"example.com/m/v2", "v2.0.0+incompatible", false},
+		{"example.com/m/v1", "v1.0.0", false},
+		{"example.com/m/v02", "v2.0.0", false},
+		{"example.com/vendor", "v1.0.0", true},
+		{"gopkg.in/yaml.v3", "v3.0.1", true},
+		{"gopkg.in/yaml.v1", "v1.0.0", true},
+		{"gopkg.in/yaml.v3", "v2.4.0", false},
+		{"gopkg.in/yaml", "v1.0.0", false},
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.v)
+		if got := v.IsGoModCompatible(tt.path); got != tt.want {
+			t.Errorf("IsGoModCompatible(%q, %q) = %v, want %v", tt.v, tt.path, got, tt.want)
+		}
+	}
+}
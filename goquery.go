@@ -0,0 +1,124 @@
+package semver
+
+import "strings"
+
+// ResolveQuery resolves a Go module version query ("latest", "v1",
+// ">=v1.2.0", ...) against the available versions as "go get mod@query"
+// does without a current version. See ResolveQueryFrom.
+func ResolveQuery(q string, available List) (Semver, error) {
+	return ResolveQueryFrom(q, Semver{}, available)
+}
+
+// ResolveQueryFrom resolves a Go module version query against the available
+// versions, current is the version already required (invalid if none).
+//
+// Supported queries (the 'v' prefix is optional):
+//
+//	latest          highest release, or highest prerelease if there is none
+//	upgrade         like latest, but keeps current if it is higher
+//	patch           highest version of current's MAJOR.MINOR, like upgrade;
+//	                latest without a current version
+//	v1.2.3          exactly that version (fails with ErrVersionNotFound)
+//	v1, v1.2        highest version with that prefix
+//	<v1.2.3, <=…    highest version below (or at) the bound
+//	>v1.2.3, >=…    lowest version above (or at) the bound
+//
+// Except for an exact version, releases are preferred over prereleases.
+// Invalid versions in available are ignored. Fails with ErrInvalidQuery for
+// malformed queries (branch and commit names included) and ErrNoMatch if no
+// version matches.
+func ResolveQueryFrom(q string, current Semver, available List) (Semver, error) {
+	q = strings.TrimSpace(q)
+
+	switch q {
+	case "latest":
+		return queryPick(available, func(Semver) bool { return true }, true)
+
+	case "upgrade", "patch":
+		match := func(Semver) bool { return true }
+		if q == "patch" && current.Valid {
+			match = func(v Semver) bool { return v.Major == current.Major && v.Minor == current.Minor }
+		}
+
+		v, err := queryPick(available, match, true)
+		if current.Valid && (err != nil || current.Compare(v) > 0) {
+			return current, nil
+		}
+		return v, err
+	}
+
+	for _, op := range []string{"<=", ">=", "<", ">"} {
+		rest, ok := strings.CutPrefix(q, op)
+		if !ok {
+			continue
+		}
+
+		bound, ok := Parse(rest)
+		if !ok {
+			return Semver{}, ErrInvalidQuery
+		}
+
+		var match func(Semver) bool
+		switch op {
+		case "<=":
+			match = func(v Semver) bool { return v.Compare(bound) <= 0 }
+		case ">=":
+			match = func(v Semver) bool { return v.Compare(bound) >= 0 }
+		case "<":
+			match = func(v Semver) bool { return v.Compare(bound) < 0 }
+		default:
+			match = func(v Semver) bool { return v.Compare(bound) > 0 }
+		}
+
+		return queryPick(available, match, op[0] == '<')
+	}
+
+	want, ok := Parse(q)
+	if !ok {
+		return Semver{}, ErrInvalidQuery
+	}
+
+	// exact version
+	if want.HasPatch() {
+		for _, v := range available {
+			if v.Valid && v.IsEqual(want) {
+				return v, nil
+			}
+		}
+		return Semver{}, ErrVersionNotFound
+	}
+
+	// version prefix
+	return queryPick(available, func(v Semver) bool {
+		return v.Major == want.Major && (!want.HasMinor() || v.Minor == want.Minor)
+	}, true)
+}
+
+// queryPick returns the highest (or lowest) matching valid version,
+// preferring releases over prereleases. Fails with ErrNoMatch.
+func queryPick(available List, match func(Semver) bool, highest bool) (Semver, error) {
+	var rel, pre Semver
+	for _, v := range available {
+		if !v.Valid || !match(v) {
+			continue
+		}
+
+		best := &rel
+		if v.HasPre() {
+			best = &pre
+		}
+		r := v.Compare(*best)
+		if !best.Valid || highest && r > 0 || !highest && r < 0 {
+			*best = v
+		}
+	}
+
+	switch {
+	case rel.Valid:
+		return rel, nil
+	case pre.Valid:
+		return pre, nil
+	}
+
+	return Semver{}, ErrNoMatch
+}
@@ -0,0 +1,59 @@
+package semver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestResolveQuery(t *testing.T) {
+	available := mustList(
+		"v1.0.0", "v1.1.0", "v1.1.1", "v1.2.0-rc.1", "v1.2.0", "v1.2.1",
+		"v1.3.0-beta.1", "v2.0.0", "v2.1.0-rc.1", "v3.0.0-alpha.1", "bad",
+	)
+
+	tests := []struct {
+		q, current string
+		want       string
+		err        error
+	}{
+		{"latest", "", "v2.0.0", nil},
+		{"upgrade", "", "v2.0.0", nil},
+		{"upgrade", "v1.2.0", "v2.0.0", nil},
+		{"upgrade", "v3.0.0-alpha.2", "v3.0.0-alpha.2", nil},
+		{"patch", "v1.1.0", "v1.1.1", nil},
+		{"patch", "v1.3.0-beta.1", "v1.3.0-beta.1", nil},
+		{"patch", "v1.3.0-alpha", "v1.3.0-beta.1", nil},
+		{"patch", "", "v2.0.0", nil},
+		{"v1", "", "v1.2.1", nil},
+		{"1.1", "", "v1.1.1", nil},
+		{"v3", "", "v3.0.0-alpha.1", nil},
+		{"v4", "", "", ErrNoMatch},
+		{"v1.2.0", "", "v1.2.0", nil},
+		{"v1.2.0-rc.1", "", "v1.2.0-rc.1", nil},
+		{"v1.2.2", "", "", ErrVersionNotFound},
+		{"<v1.2.0", "", "v1.1.1", nil},
+		{"<=v1.2.0", "", "v1.2.0", nil},
+		{">v1.2.1", "", "v2.0.0", nil},
+		{">=v1.1", "", "v1.1.0", nil},
+		{">v2.0.0", "", "v2.1.0-rc.1", nil},
+		{"<v1.0.0", "", "", ErrNoMatch},
+		{"master", "", "", ErrInvalidQuery},
+		{">=", "", "", ErrInvalidQuery},
+	}
+
+	for _, tt := range tests {
+		current, _ := Parse(tt.current)
+		got, err := ResolveQueryFrom(tt.q, current, available)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("ResolveQueryFrom(%q, %q) error = %v, want %v", tt.q, tt.current, err, tt.err)
+			continue
+		}
+		if err == nil && got.Original != tt.want {
+			t.Errorf("ResolveQueryFrom(%q, %q) = %q, want %q", tt.q, tt.current, got.Original, tt.want)
+		}
+	}
+
+	if got, err := ResolveQuery("latest", mustList("v0.1.0-rc.1", "v0.1.0-rc.2")); err != nil || got.Original != "v0.1.0-rc.2" {
+		t.Errorf("ResolveQuery(latest) = %q, %v, want v0.1.0-rc.2", got.Original, err)
+	}
+}
@@ -0,0 +1,83 @@
+package semver
+
+import (
+	"fmt"
+	"sync"
+)
+
+// GuardStore persists the highest version a VersionGuard has accepted,
+// e.g. in a file or a database row. Implementations used by one guard
+// need not be safe for concurrent use.
+type GuardStore interface {
+	// LoadVersion returns the stored version, or an invalid (zero)
+	// Semver if nothing was stored yet.
+	LoadVersion() (Semver, error)
+
+	// SaveVersion stores v.
+	SaveVersion(v Semver) error
+}
+
+// VersionGuard records the highest version observed and rejects
+// regressions, e.g. to prevent accidental downgrade deployments.
+// It is safe for concurrent use. The zero value is ready to use and
+// not persisted.
+type VersionGuard struct {
+	store   GuardStore
+	current Semver
+	mu      sync.Mutex
+}
+
+// NewVersionGuard returns a VersionGuard persisted in store (nil for none),
+// starting from the version stored there.
+func NewVersionGuard(store GuardStore) (*VersionGuard, error) {
+	g := &VersionGuard{store: store}
+	if store == nil {
+		return g, nil
+	}
+
+	v, err := store.LoadVersion()
+	if err != nil {
+		return nil, err
+	}
+	g.current = v
+
+	return g, nil
+}
+
+// Current returns the highest accepted version, invalid if none yet.
+func (g *VersionGuard) Current() Semver {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.current
+}
+
+// Update accepts v if it is not lower than the current version and records
+// it when it is higher (versions of equal precedence are accepted without
+// change). Fails with ErrInvalidVersion for invalid v, with an error
+// wrapping ErrVersionRegression for lower v, or with the store error, in
+// which case the current version is kept.
+func (g *VersionGuard) Update(v Semver) error {
+	if !v.Valid {
+		return ErrInvalidVersion
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	switch {
+	case v.IsLower(g.current):
+		return fmt.Errorf("%w: %s is lower than %s", ErrVersionRegression, v.Original, g.current.Original)
+	case g.current.Valid && v.IsEqual(g.current):
+		return nil
+	}
+
+	if g.store != nil {
+		if err := g.store.SaveVersion(v); err != nil {
+			return err
+		}
+	}
+	g.current = v
+
+	return nil
+}
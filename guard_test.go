@@ -0,0 +1,92 @@
+package semver
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+// memStore is a GuardStore recording saves.
+type memStore struct {
+	err   error
+	v     Semver
+	saves int
+}
+
+func (s *memStore) LoadVersion() (Semver, error) { return s.v, nil }
+
+func (s *memStore) SaveVersion(v Semver) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.v = v
+	s.saves++
+	return nil
+}
+
+func TestVersionGuard(t *testing.T) {
+	var g VersionGuard
+
+	steps := []struct {
+		v   string
+		err error
+	}{
+		{"1.0.0", nil},
+		{"1.2.0", nil},
+		{"1.1.0", ErrVersionRegression},
+		{"1.2.0+rebuild", nil},
+		{"1.2.0-rc.1", ErrVersionRegression},
+		{"bad", ErrInvalidVersion},
+		{"2.0.0", nil},
+	}
+
+	for _, s := range steps {
+		v, _ := Parse(s.v)
+		if err := g.Update(v); !errors.Is(err, s.err) {
+			t.Errorf("Update(%q) = %v, want %v", s.v, err, s.err)
+		}
+	}
+	if got := g.Current(); got.Original != "2.0.0" {
+		t.Errorf("Current = %q, want 2.0.0", got.Original)
+	}
+}
+
+func TestVersionGuard_Store(t *testing.T) {
+	store := &memStore{v: MustParse("1.5.0")}
+	g, err := NewVersionGuard(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := g.Update(MustParse("1.4.0")); !errors.Is(err, ErrVersionRegression) {
+		t.Errorf("Update below stored version = %v", err)
+	}
+	if err := g.Update(MustParse("1.5.0")); err != nil || store.saves != 0 {
+		t.Errorf("Update(equal) = %v, saves %d", err, store.saves)
+	}
+	if err := g.Update(MustParse("1.6.0")); err != nil || store.v.Original != "1.6.0" {
+		t.Errorf("Update(1.6.0) = %v, stored %q", err, store.v.Original)
+	}
+
+	store.err = errors.New("disk full")
+	if err := g.Update(MustParse("2.0.0")); !errors.Is(err, store.err) || g.Current().Original != "1.6.0" {
+		t.Errorf("Update with failing store = %v, current %q", err, g.Current().Original)
+	}
+}
+
+func TestVersionGuard_Concurrent(t *testing.T) {
+	var g VersionGuard
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_ = g.Update(New(1, i, 0))
+		}(i)
+	}
+	wg.Wait()
+
+	if got := g.Current(); got.Minor != 49 {
+		t.Errorf("Current = %q, want 1.49.0", got.Original)
+	}
+}
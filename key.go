@@ -0,0 +1,91 @@
+package semver
+
+import "strconv"
+
+// Key is the precedence identity of a version: a small comparable value for
+// map keys and semantic deduplication. Two versions have equal keys exactly
+// when IsEqual reports true, so build metadata, the 'v' prefix and
+// shorthand formatting are ignored. All invalid versions share the zero Key.
+type Key struct {
+	Prerelease string
+	Epoch      int
+	Major      int
+	Minor      int
+	Patch      int
+	Revision   int
+	Valid      bool
+}
+
+// Key returns the precedence identity of v.
+func (v Semver) Key() Key {
+	if !v.Valid {
+		return Key{}
+	}
+
+	k := Key{
+		Epoch:    v.Epoch,
+		Major:    v.Major,
+		Minor:    v.Minor,
+		Patch:    v.Patch,
+		Revision: v.Revision,
+		Valid:    true,
+	}
+	if v.Flags&FlagHasPre != 0 {
+		k.Prerelease = v.Prerelease
+	}
+
+	return k
+}
+
+// FNV-1a 64-bit parameters used by Key.Hash64.
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// Hash64 returns a hash of the precedence identity of v, see Key.Hash64.
+func (v Semver) Hash64() uint64 {
+	return v.Key().Hash64()
+}
+
+// Hash64 returns the 64-bit FNV-1a hash of the text
+//
+//	EPOCH:MAJOR.MINOR.PATCH.REVISION[-PRERELEASE]
+//
+// with decimal numbers ("0:1.2.3.0-rc.1" for "v1.2.3-rc.1+b.5"). The
+// encoding is part of the API and will not change, so the value is stable
+// across processes, architectures and releases of this package and fit for
+// sharding and bucketing. Equal keys hash equal; the zero Key (invalid
+// versions) hashes to 0.
+func (k Key) Hash64() uint64 {
+	if !k.Valid {
+		return 0
+	}
+
+	var buf [128]byte
+	b := strconv.AppendInt(buf[:0], int64(k.Epoch), 10)
+	b = append(b, ':')
+	b = strconv.AppendInt(b, int64(k.Major), 10)
+	b = append(b, '.')
+	b = strconv.AppendInt(b, int64(k.Minor), 10)
+	b = append(b, '.')
+	b = strconv.AppendInt(b, int64(k.Patch), 10)
+	b = append(b, '.')
+	b = strconv.AppendInt(b, int64(k.Revision), 10)
+
+	h := uint64(fnvOffset64)
+	for _, c := range b {
+		h ^= uint64(c)
+		h *= fnvPrime64
+	}
+	if k.Prerelease != "" {
+		h ^= '-'
+		h *= fnvPrime64
+		for i := 0; i < len(k.Prerelease); i++ {
+			h ^= uint64(k.Prerelease[i])
+			h *= fnvPrime64
+		}
+	}
+
+	return h
+}
@@ -0,0 +1,63 @@
+package semver
+
+import "testing"
+
+func TestKey(t *testing.T) {
+	ls := mustList("1.2", "v1.2.0", "1.2.0+build.5", "V1.2.0-rc.1", "1.2.0-rc.1+b", "1.2.1", "bad", "worse")
+
+	seen := make(map[Key]Semver)
+	for _, v := range ls {
+		if _, ok := seen[v.Key()]; !ok {
+			seen[v.Key()] = v
+		}
+	}
+	if len(seen) != 4 {
+		t.Fatalf("distinct keys = %d, want 4: %v", len(seen), seen)
+	}
+
+	// key equality matches IsEqual
+	for _, a := range ls {
+		for _, b := range ls {
+			if (a.Key() == b.Key()) != a.IsEqual(b) {
+				t.Errorf("Key equality of %q and %q disagrees with IsEqual", a.Original, b.Original)
+			}
+		}
+	}
+
+	rev, _ := ParseWith("1.2.0.1", ParseOptions{AllowRevision: true})
+	if rev.Key() == MustParse("1.2.0").Key() {
+		t.Errorf("revision must be part of the key")
+	}
+}
+
+func TestHash64(t *testing.T) {
+	cases := []struct {
+		in   string
+		want uint64
+	}{
+		{"v1.2.3-rc.1+b.5", 0xfbca0fff80ce862c},
+		{"1.2.3-rc.1", 0xfbca0fff80ce862c},
+		{"bad", 0},
+	}
+
+	for _, tc := range cases {
+		v, _ := Parse(tc.in)
+		if got := v.Hash64(); got != tc.want {
+			t.Errorf("Hash64(%q) = %#x, want %#x", tc.in, got, tc.want)
+		}
+	}
+
+	a, b := mustList("1.2", "1.2.0+build.5"), mustList("1.2.1")
+	if a[0].Hash64() != a[1].Hash64() {
+		t.Errorf("equal precedence hashes differ: %q %q", a[0].Original, a[1].Original)
+	}
+	if a[0].Hash64() == b[0].Hash64() {
+		t.Errorf("Hash64(%q) == Hash64(%q)", a[0].Original, b[0].Original)
+	}
+
+	k := MustParse("1.2.3-rc.1").Key()
+	var h uint64
+	if n := testing.AllocsPerRun(100, func() { h = k.Hash64() }); n != 0 || h == 0 {
+		t.Errorf("Key.Hash64 allocates %v times", n)
+	}
+}
@@ -1,45 +1,221 @@
 package semver
 
-import "sort"
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
 
-// List is a slice of Semver values that implements sort.Interface.
+// List is a slice of Semver values.
 // Elements are ordered by semantic version precedence with a
-// lexicographic tie-breaker on Original.
+// lexicographic tie-breaker on Original, see CompareForSort.
 type List []Semver
 
 // Len implements sort.Interface.
+//
+// Deprecated: List sorts with slices.SortFunc; use Sort or CompareForSort.
 func (ls List) Len() int {
 	return len(ls)
 }
 
 // Swap implements sort.Interface.
+//
+// Deprecated: List sorts with slices.SortFunc; use Sort or CompareForSort.
 func (ls List) Swap(i, j int) {
 	ls[i], ls[j] = ls[j], ls[i]
 }
 
-// Less orders by semantic version precedence; if two values compare equal
-// it falls back to lexicographic order of Original (or Canonical()) to
-// produce a stable, deterministic order.
+// Less implements sort.Interface in CompareForSort order.
+//
+// Deprecated: List sorts with slices.SortFunc; use Sort or CompareForSort.
 func (ls List) Less(i, j int) bool {
-	c := ls[i].Compare(ls[j])
-	if c != 0 {
-		return c < 0
+	return less(ls[i], ls[j])
+}
+
+// CompareForSort orders by semantic version precedence; if two values compare
+// equal it falls back to lexicographic order of Original (or Canonical() when
+// Original is empty) to produce a stable, deterministic order.
+// Returns -1, 0 or +1 and is suitable for slices.SortFunc and similar APIs.
+func CompareForSort(a, b Semver) int {
+	if c := a.Compare(b); c != 0 {
+		return c
 	}
 
-	ai := ls[i].Original
+	ai := a.Original
 	if ai == "" {
-		ai = ls[i].Canonical()
+		ai = a.Canonical()
 	}
 
-	aj := ls[j].Original
-	if aj == "" {
-		aj = ls[j].Canonical()
+	bi := b.Original
+	if bi == "" {
+		bi = b.Canonical()
 	}
 
-	return ai < aj
+	return strings.Compare(ai, bi)
+}
+
+// less reports whether a sorts before b in CompareForSort order.
+func less(a, b Semver) bool {
+	return CompareForSort(a, b) < 0
 }
 
 // Sort sorts the list in ascending semver order.
+// Invalid versions sort first, see SortWith for other placements.
 func (ls List) Sort() {
-	sort.Sort(ls)
+	slices.SortFunc(ls, CompareForSort)
+}
+
+// InvalidOrder selects how SortWith handles invalid versions.
+type InvalidOrder uint8
+
+const (
+	InvalidFirst   InvalidOrder = iota // invalid versions first, like Sort
+	InvalidLast                        // invalid versions last
+	DropInvalid                        // invalid versions removed
+	ErrorOnInvalid                     // fail on the first invalid version
+)
+
+// SortWith sorts the list in ascending semver order in place and returns it,
+// placing invalid versions as selected by order. Invalid versions keep
+// CompareForSort order among themselves (by Original).
+//
+// DropInvalid returns a shortened ls (the tail beyond it is zeroed).
+// ErrorOnInvalid leaves ls unchanged and fails with an error wrapping
+// ErrInvalidVersion.
+func (ls List) SortWith(order InvalidOrder) (List, error) {
+	switch order {
+	case InvalidLast:
+		slices.SortFunc(ls, func(a, b Semver) int {
+			if a.Valid != b.Valid {
+				if a.Valid {
+					return -1
+				}
+				return 1
+			}
+			return CompareForSort(a, b)
+		})
+
+	case DropInvalid:
+		n := 0
+		for _, v := range ls {
+			if v.Valid {
+				ls[n] = v
+				n++
+			}
+		}
+		clear(ls[n:])
+		ls = ls[:n]
+		ls.Sort()
+
+	case ErrorOnInvalid:
+		for _, v := range ls {
+			if !v.Valid {
+				return ls, fmt.Errorf("%w: %q", ErrInvalidVersion, v.Original)
+			}
+		}
+		ls.Sort()
+
+	default:
+		ls.Sort()
+	}
+
+	return ls, nil
+}
+
+// GroupByMajor splits the list into release lines keyed by MAJOR.
+// Invalid versions are skipped. Each group keeps the input order;
+// call Sort on a group when ordered output is required.
+func (ls List) GroupByMajor() map[int]List {
+	groups := make(map[int]List)
+	for _, v := range ls {
+		if !v.Valid {
+			continue
+		}
+
+		groups[v.Major] = append(groups[v.Major], v)
+	}
+
+	return groups
+}
+
+// GroupByMinor splits the list into release lines keyed by [MAJOR, MINOR].
+// Invalid versions are skipped. Each group keeps the input order;
+// call Sort on a group when ordered output is required.
+func (ls List) GroupByMinor() map[[2]int]List {
+	groups := make(map[[2]int]List)
+	for _, v := range ls {
+		if !v.Valid {
+			continue
+		}
+
+		key := [2]int{v.Major, v.Minor}
+		groups[key] = append(groups[key], v)
+	}
+
+	return groups
+}
+
+// LatestPerMajor returns the newest version of each MAJOR release line.
+// Invalid versions are skipped. When several entries share the highest
+// precedence (e.g. differ only in build metadata), the first one wins.
+func (ls List) LatestPerMajor() map[int]Semver {
+	latest := make(map[int]Semver)
+	for _, v := range ls {
+		if !v.Valid {
+			continue
+		}
+
+		if cur, ok := latest[v.Major]; !ok || v.IsGreater(cur) {
+			latest[v.Major] = v
+		}
+	}
+
+	return latest
+}
+
+// LatestPerMinor returns the newest version of each MAJOR.MINOR release line.
+// Invalid versions are skipped. When several entries share the highest
+// precedence (e.g. differ only in build metadata), the first one wins.
+func (ls List) LatestPerMinor() map[[2]int]Semver {
+	latest := make(map[[2]int]Semver)
+	for _, v := range ls {
+		if !v.Valid {
+			continue
+		}
+
+		key := [2]int{v.Major, v.Minor}
+		if cur, ok := latest[key]; !ok || v.IsGreater(cur) {
+			latest[key] = v
+		}
+	}
+
+	return latest
+}
+
+// Filter returns the versions satisfying c, see Constraint.Check.
+// The result keeps the input order and never aliases ls; call Sort on the
+// receiver or the result when ordered output is required.
+func (ls List) Filter(c Constraint) List {
+	out := make(List, 0, len(ls))
+	for _, v := range ls {
+		if c.Check(v) {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+// Partition splits the list into the versions satisfying c and the rest
+// (invalid versions included). Both parts keep the input order.
+func (ls List) Partition(c Constraint) (match, rest List) {
+	for _, v := range ls {
+		if c.Check(v) {
+			match = append(match, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+
+	return match, rest
 }
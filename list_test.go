@@ -0,0 +1,172 @@
+package semver
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// mustList parses inputs into a List, keeping invalid entries as-is.
+func mustList(in ...string) List {
+	ls := make(List, len(in))
+	for i, s := range in {
+		ls[i], _ = Parse(s)
+	}
+
+	return ls
+}
+
+// TestGroupByMajor checks grouping by MAJOR and skipping of invalid inputs.
+func TestGroupByMajor(t *testing.T) {
+	ls := mustList("1.2.3", "bad", "2.0.0", "1.0.0", "v2.1.0-rc.1")
+
+	g := ls.GroupByMajor()
+	if len(g) != 2 {
+		t.Fatalf("GroupByMajor: got %d groups, want 2", len(g))
+	}
+	if len(g[1]) != 2 || g[1][0].Original != "1.2.3" || g[1][1].Original != "1.0.0" {
+		t.Errorf("GroupByMajor[1] = %v", g[1])
+	}
+	if len(g[2]) != 2 {
+		t.Errorf("GroupByMajor[2] = %v", g[2])
+	}
+}
+
+// TestGroupByMinor checks grouping by MAJOR.MINOR including shorthands.
+func TestGroupByMinor(t *testing.T) {
+	ls := mustList("1.2.3", "1.2", "1.3.0", "1", "bad")
+
+	g := ls.GroupByMinor()
+	if len(g) != 3 {
+		t.Fatalf("GroupByMinor: got %d groups, want 3", len(g))
+	}
+	if len(g[[2]int{1, 2}]) != 2 {
+		t.Errorf("GroupByMinor[1.2] = %v", g[[2]int{1, 2}])
+	}
+	if len(g[[2]int{1, 0}]) != 1 || len(g[[2]int{1, 3}]) != 1 {
+		t.Errorf("GroupByMinor: unexpected groups %v", g)
+	}
+}
+
+// TestLatestPerTrack checks newest entry selection per MAJOR and MAJOR.MINOR.
+func TestLatestPerTrack(t *testing.T) {
+	ls := mustList("1.2.3", "1.2.4-rc.1", "1.3.0", "1.2.4", "2.0.0-rc.1", "bad", "1.2.4+build")
+
+	major := ls.LatestPerMajor()
+	if got := major[1].Original; got != "1.3.0" {
+		t.Errorf("LatestPerMajor[1] = %q, want 1.3.0", got)
+	}
+	if got := major[2].Original; got != "2.0.0-rc.1" {
+		t.Errorf("LatestPerMajor[2] = %q, want 2.0.0-rc.1", got)
+	}
+
+	minor := ls.LatestPerMinor()
+	if len(minor) != 3 {
+		t.Fatalf("LatestPerMinor: got %d tracks, want 3", len(minor))
+	}
+	if got := minor[[2]int{1, 2}].Original; got != "1.2.4" {
+		t.Errorf("LatestPerMinor[1.2] = %q, want 1.2.4 (first of equal precedence)", got)
+	}
+}
+
+// TestCompareForSort checks precedence and the Original tie-breaker.
+func TestCompareForSort(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.3", "1.2.4", -1},
+		{"1.2.3-rc.1", "1.2.3", -1},
+		{"1.2.3+b", "1.2.3+a", 1},
+		{"v1.2.3", "1.2.3", 1},
+		{"1.2.3", "1.2.3", 0},
+		{"bad", "1.0.0", -1},
+	}
+
+	for _, tt := range tests {
+		a, _ := Parse(tt.a)
+		b, _ := Parse(tt.b)
+		if got := CompareForSort(a, b); got != tt.want {
+			t.Errorf("CompareForSort(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	// Original falls back to Canonical
+	x := MustParse("1.2.3+a")
+	x.Original = ""
+	if got := CompareForSort(x, MustParse("v1.2.3")); got != 0 {
+		t.Errorf("CompareForSort with empty Original = %d, want 0", got)
+	}
+}
+
+func TestFilterPartition(t *testing.T) {
+	ls := mustList("2.0.0", "1.4.0", "bad", "1.2.0", "1.5.0-rc.1", "1.3.0")
+	c := MustConstraint("^1.2")
+
+	if got := listOriginals(ls.Filter(c)); got != "1.4.0 1.2.0 1.3.0" {
+		t.Errorf("Filter = %q", got)
+	}
+
+	match, rest := ls.Partition(c)
+	if got := listOriginals(match); got != "1.4.0 1.2.0 1.3.0" {
+		t.Errorf("Partition match = %q", got)
+	}
+	if got := listOriginals(rest); got != "2.0.0 bad 1.5.0-rc.1" {
+		t.Errorf("Partition rest = %q", got)
+	}
+
+	sorted := slices.Clone(ls)
+	sorted.Sort()
+	if got := listOriginals(sorted.Filter(c)); got != "1.2.0 1.3.0 1.4.0" {
+		t.Errorf("sorted Filter = %q", got)
+	}
+
+	// Filter never aliases the receiver
+	f := ls.Filter(MustConstraint("*"))
+	f[0] = Semver{}
+	if !ls[0].Valid {
+		t.Error("Filter result aliases the receiver")
+	}
+}
+
+// listOriginals joins the Original of every element with spaces.
+func listOriginals(ls List) string {
+	out := make([]string, len(ls))
+	for i, v := range ls {
+		out[i] = v.Original
+	}
+
+	return strings.Join(out, " ")
+}
+
+func TestSortWith(t *testing.T) {
+	in := []string{"1.2.0", "zzz", "v1.0.0", "aaa", "0.9.0"}
+
+	tests := []struct {
+		order InvalidOrder
+		want  string
+		err   bool
+	}{
+		{InvalidFirst, "aaa zzz 0.9.0 v1.0.0 1.2.0", false},
+		{InvalidLast, "0.9.0 v1.0.0 1.2.0 aaa zzz", false},
+		{DropInvalid, "0.9.0 v1.0.0 1.2.0", false},
+		{ErrorOnInvalid, "1.2.0 zzz v1.0.0 aaa 0.9.0", true},
+	}
+
+	for _, tt := range tests {
+		ls := mustList(in...)
+		got, err := ls.SortWith(tt.order)
+		if (err != nil) != tt.err || err != nil && !errors.Is(err, ErrInvalidVersion) {
+			t.Errorf("SortWith(%d) error = %v", tt.order, err)
+		}
+		if s := listOriginals(got); s != tt.want {
+			t.Errorf("SortWith(%d) = %q, want %q", tt.order, s, tt.want)
+		}
+	}
+
+	ls := mustList("2.0.0", "1.0.0")
+	if got, err := ls.SortWith(ErrorOnInvalid); err != nil || listOriginals(got) != "1.0.0 2.0.0" {
+		t.Errorf("SortWith(ErrorOnInvalid) on valid list = %v, %v", got, err)
+	}
+}
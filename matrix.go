@@ -0,0 +1,113 @@
+package semver
+
+import (
+	"math/bits"
+	"slices"
+	"sort"
+)
+
+// Matches is the result of MatchMatrix: a bit matrix with one row per
+// constraint and one column per version, in input order.
+type Matches struct {
+	bits     []uint64
+	rows     int
+	cols     int
+	rowWords int
+}
+
+// MatchMatrix reports which versions satisfy which constraints (see
+// Constraint.Check) for vulnerability scanners matching many advisories
+// against many versions. Instead of V*C checks it sorts the versions once
+// and sweeps each constraint's intervals over them, costing
+// O(V log V + C*(I log V + M)) for I intervals per constraint and M matches.
+func MatchMatrix(versions List, constraints []Constraint) *Matches {
+	rowWords := (len(versions) + 63) / 64
+	m := &Matches{
+		bits:     make([]uint64, len(constraints)*rowWords),
+		rows:     len(constraints),
+		cols:     len(versions),
+		rowWords: rowWords,
+	}
+
+	order := make([]int, 0, len(versions))
+	for i, v := range versions {
+		if v.Valid {
+			order = append(order, i)
+		}
+	}
+	slices.SortFunc(order, func(a, b int) int {
+		return versions[a].Compare(versions[b])
+	})
+
+	for row, c := range constraints {
+		cc := c.Compile()
+		words := m.bits[row*rowWords : (row+1)*rowWords]
+
+		for _, iv := range cc.all {
+			start := sort.Search(len(order), func(k int) bool {
+				return !iv.below(versions[order[k]])
+			})
+			for _, col := range order[start:] {
+				v := versions[col]
+				if !iv.contains(v) {
+					break
+				}
+				if v.Flags&FlagHasPre != 0 && !cc.Check(v) {
+					continue
+				}
+				words[col/64] |= 1 << (col % 64)
+			}
+		}
+	}
+
+	return m
+}
+
+// below reports whether v lies below the lower bound of iv.
+func (iv interval) below(v Semver) bool {
+	if !iv.lo.set {
+		return false
+	}
+
+	r := v.Compare(iv.lo.v)
+	return r < 0 || r == 0 && !iv.lo.incl
+}
+
+// Rows returns the number of constraints.
+func (m *Matches) Rows() int {
+	return m.rows
+}
+
+// Cols returns the number of versions.
+func (m *Matches) Cols() int {
+	return m.cols
+}
+
+// Has reports whether versions[v] satisfies constraints[c].
+func (m *Matches) Has(c, v int) bool {
+	return m.bits[c*m.rowWords+v/64]&(1<<(v%64)) != 0
+}
+
+// Count returns the number of versions satisfying constraints[c].
+func (m *Matches) Count(c int) int {
+	n := 0
+	for _, w := range m.bits[c*m.rowWords : (c+1)*m.rowWords] {
+		n += bits.OnesCount64(w)
+	}
+
+	return n
+}
+
+// Versions returns the ascending indices of the versions satisfying
+// constraints[c].
+func (m *Matches) Versions(c int) []int {
+	var out []int
+	for i, w := range m.bits[c*m.rowWords : (c+1)*m.rowWords] {
+		for w != 0 {
+			out = append(out, i*64+bits.TrailingZeros64(w))
+			w &= w - 1
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,50 @@
+package semver
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestMatchMatrix checks the matrix against per-pair Check.
+func TestMatchMatrix(t *testing.T) {
+	versions := mustList(
+		"bad", "1.2.3", "v1.2.3+b", "1.5.0-rc.1", "2.0.0", "1.0.0", "3.0.0-beta",
+		"3.0.0", "1.4.0", "0.9.0",
+	)
+	// Pad past one bitset word.
+	for i := 0; i < 70; i++ {
+		versions = append(versions, MustParse(fmt.Sprintf("4.%d.0", i)))
+	}
+	constraints := []Constraint{
+		MustConstraint("^1.2.0"),
+		MustConstraint(">=1.5.0-beta <2 || >=3.0.0-rc.1"),
+		MustConstraint("^1.2 !=1.4.0 || 4.10 - 4.65"),
+		MustConstraint("*"),
+		MustConstraint("<0.0.0-0"),
+	}
+
+	m := MatchMatrix(versions, constraints)
+	if m.Rows() != len(constraints) || m.Cols() != len(versions) {
+		t.Fatalf("dims = %dx%d, want %dx%d", m.Rows(), m.Cols(), len(constraints), len(versions))
+	}
+
+	for c, cons := range constraints {
+		var want []int
+		for v, ver := range versions {
+			ok := cons.Check(ver)
+			if m.Has(c, v) != ok {
+				t.Errorf("Has(%q, %s) = %v, want %v", cons, ver.Original, m.Has(c, v), ok)
+			}
+			if ok {
+				want = append(want, v)
+			}
+		}
+
+		if got := m.Versions(c); fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("Versions(%q) = %v, want %v", cons, got, want)
+		}
+		if m.Count(c) != len(want) {
+			t.Errorf("Count(%q) = %d, want %d", cons, m.Count(c), len(want))
+		}
+	}
+}
@@ -0,0 +1,318 @@
+/*
+Package maven implements Maven's ComparableVersion ordering, so JVM artifact
+versions ("1.0.Final", "2.1-SNAPSHOT", "1.0-sp1", "1.0a1") can be sorted
+together with semver tags held in a semver.List.
+
+Versions are split into numeric and string items on '.', '-' and on
+transitions between digits and letters. Well-known qualifiers are ordered
+
+	alpha < beta < milestone < rc = cr < snapshot < "" = ga = final = release < sp
+
+while unknown qualifiers sort after "sp" lexicographically. Single letter
+qualifiers followed by a digit are aliases: "a1" = "alpha-1", "b1" =
+"beta-1", "m1" = "milestone-1". Every input is a valid Maven version.
+*/
+package maven
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/woozymasta/semver"
+)
+
+// Version is a parsed Maven version.
+type Version struct {
+	// Original the raw input string
+	Original string
+
+	// items parsed and normalized item tree
+	items *listItem
+}
+
+// Parse parses s with Maven ComparableVersion rules. It never fails.
+func Parse(s string) Version {
+	return Version{Original: s, items: parseItems(strings.ToLower(s))}
+}
+
+// Compare compares v with w. Returns -1, 0 or +1.
+func (v Version) Compare(w Version) int {
+	a, b := v.items, w.items
+	if a == nil {
+		a = &listItem{}
+	}
+	if b == nil {
+		b = &listItem{}
+	}
+
+	return a.compare(b)
+}
+
+// Compare parses and compares two Maven versions. Returns -1, 0 or +1.
+func Compare(a, b string) int {
+	return Parse(a).Compare(Parse(b))
+}
+
+// CompareSemver compares two Semver values using Maven ordering of their
+// Original strings (Canonical for values without Original). Unlike
+// Semver.Compare, build metadata takes part in the comparison because
+// Maven has no notion of it.
+func CompareSemver(a, b semver.Semver) int {
+	return Compare(original(a), original(b))
+}
+
+// Sort sorts ls in ascending Maven order with a lexicographic tie-breaker
+// on Original, mirroring semver.List.Sort.
+func Sort(ls semver.List) {
+	sort.SliceStable(ls, func(i, j int) bool {
+		ai, aj := original(ls[i]), original(ls[j])
+		if c := Compare(ai, aj); c != 0 {
+			return c < 0
+		}
+		return ai < aj
+	})
+}
+
+// original returns v.Original or the canonical form if it is empty.
+func original(v semver.Semver) string {
+	if v.Original != "" {
+		return v.Original
+	}
+
+	return v.Canonical()
+}
+
+// item is a node of the parsed version: intItem, stringItem or *listItem.
+// compare accepts nil meaning "absent item" (padding for shorter versions).
+type item interface {
+	compare(other item) int
+	isNull() bool
+}
+
+// intItem is a numeric item kept as decimal digits without leading zeros,
+// so arbitrarily large numbers compare correctly.
+type intItem string
+
+// stringItem is a qualifier after alias resolution ("ga" -> "", "cr" -> "rc").
+type stringItem string
+
+// listItem is a sub-list started by '-' or a digit/letter transition.
+type listItem struct {
+	items []item
+}
+
+// qualifiers in ascending order; "" is the release.
+var qualifiers = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
+
+// releaseIndex is the comparable form of the release qualifier "".
+const releaseIndex = "5"
+
+// aliases maps qualifier spellings to their canonical form.
+var aliases = map[string]string{"ga": "", "final": "", "release": "", "cr": "rc"}
+
+func (i intItem) isNull() bool {
+	return i == ""
+}
+
+func (i intItem) compare(other item) int {
+	switch o := other.(type) {
+	case nil:
+		if i == "" {
+			return 0
+		}
+		return 1
+	case intItem:
+		if len(i) != len(o) {
+			return sign(len(i) - len(o))
+		}
+		return strings.Compare(string(i), string(o))
+	case stringItem:
+		return 1 // 1.1 > 1-sp
+	default:
+		return 1 // 1.1 > 1-1
+	}
+}
+
+func (s stringItem) isNull() bool {
+	return comparableQualifier(string(s)) == releaseIndex
+}
+
+func (s stringItem) compare(other item) int {
+	switch o := other.(type) {
+	case nil:
+		return strings.Compare(comparableQualifier(string(s)), releaseIndex)
+	case intItem:
+		return -1
+	case stringItem:
+		return strings.Compare(comparableQualifier(string(s)), comparableQualifier(string(o)))
+	default:
+		return -1
+	}
+}
+
+func (l *listItem) isNull() bool {
+	return len(l.items) == 0
+}
+
+func (l *listItem) compare(other item) int {
+	switch o := other.(type) {
+	case nil:
+		if len(l.items) == 0 {
+			return 0
+		}
+		return l.items[0].compare(nil)
+	case intItem:
+		return -1 // 1-1 < 1.0.x
+	case stringItem:
+		return 1 // 1-1 > 1-sp
+	case *listItem:
+		for k := 0; k < len(l.items) || k < len(o.items); k++ {
+			var left, right item
+			if k < len(l.items) {
+				left = l.items[k]
+			}
+			if k < len(o.items) {
+				right = o.items[k]
+			}
+
+			var c int
+			if left == nil {
+				if right != nil {
+					c = -right.compare(nil)
+				}
+			} else {
+				c = left.compare(right)
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+
+	return 0
+}
+
+// normalize removes trailing null items (0, "", "ga", empty lists)
+// until a non-list item is reached.
+func (l *listItem) normalize() {
+	for k := len(l.items) - 1; k >= 0; k-- {
+		it := l.items[k]
+		if it.isNull() {
+			l.items = append(l.items[:k], l.items[k+1:]...)
+		} else if _, ok := it.(*listItem); !ok {
+			break
+		}
+	}
+}
+
+// comparableQualifier maps a qualifier to a string that orders correctly:
+// its index among known qualifiers, or "7-" + qualifier for unknown ones.
+func comparableQualifier(q string) string {
+	for i, k := range qualifiers {
+		if k == q {
+			return string(rune('0' + i))
+		}
+	}
+
+	return string(rune('0'+len(qualifiers))) + "-" + q
+}
+
+// newItem creates an intItem or stringItem from a token.
+func newItem(isDigit bool, tok string, followedByDigit bool) item {
+	if isDigit {
+		return intItem(strings.TrimLeft(tok, "0"))
+	}
+
+	if followedByDigit && len(tok) == 1 {
+		switch tok {
+		case "a":
+			tok = "alpha"
+		case "b":
+			tok = "beta"
+		case "m":
+			tok = "milestone"
+		}
+	}
+	if a, ok := aliases[tok]; ok {
+		tok = a
+	}
+
+	return stringItem(tok)
+}
+
+// parseItems builds the item tree of a lowercased version string.
+func parseItems(s string) *listItem {
+	root := &listItem{}
+	list := root
+	stack := []*listItem{root}
+
+	push := func() {
+		sub := &listItem{}
+		list.items = append(list.items, sub)
+		list = sub
+		stack = append(stack, sub)
+	}
+
+	isDigit := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '.':
+			if i == start {
+				list.items = append(list.items, intItem(""))
+			} else {
+				list.items = append(list.items, newItem(isDigit, s[start:i], false))
+			}
+			start = i + 1
+
+		case c == '-':
+			if i == start {
+				list.items = append(list.items, intItem(""))
+			} else {
+				list.items = append(list.items, newItem(isDigit, s[start:i], false))
+			}
+			start = i + 1
+			push()
+
+		case '0' <= c && c <= '9':
+			if !isDigit && i > start {
+				list.items = append(list.items, newItem(false, s[start:i], true))
+				start = i
+				push()
+			}
+			isDigit = true
+
+		default:
+			if isDigit && i > start {
+				list.items = append(list.items, newItem(true, s[start:i], false))
+				start = i
+				push()
+			}
+			isDigit = false
+		}
+	}
+
+	if len(s) > start {
+		list.items = append(list.items, newItem(isDigit, s[start:], false))
+	}
+
+	for k := len(stack) - 1; k >= 0; k-- {
+		stack[k].normalize()
+	}
+
+	return root
+}
+
+// sign normalizes an integer difference to -1, 0 or +1.
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,80 @@
+package maven
+
+import (
+	"testing"
+
+	"github.com/woozymasta/semver"
+)
+
+// checkOrder asserts that every version is strictly lower than the next ones.
+func checkOrder(t *testing.T, versions []string) {
+	t.Helper()
+	for i := range versions {
+		for j := i + 1; j < len(versions); j++ {
+			if c := Compare(versions[i], versions[j]); c != -1 {
+				t.Errorf("Compare(%q, %q) = %d, want -1", versions[i], versions[j], c)
+			}
+			if c := Compare(versions[j], versions[i]); c != 1 {
+				t.Errorf("Compare(%q, %q) = %d, want 1", versions[j], versions[i], c)
+			}
+		}
+	}
+}
+
+// TestCompare_Qualifiers follows Maven's ComparableVersionTest qualifier order.
+func TestCompare_Qualifiers(t *testing.T) {
+	checkOrder(t, []string{
+		"1-alpha2snapshot", "1-alpha2", "1-alpha-123", "1-beta-2", "1-beta123",
+		"1-m2", "1-m11", "1-rc", "1-cr2", "1-rc123", "1-SNAPSHOT", "1", "1-sp",
+		"1-sp2", "1-sp123", "1-abc", "1-def", "1-pom-1", "1-1-snapshot", "1-1",
+		"1-2", "1-123",
+	})
+}
+
+// TestCompare_Numbers follows Maven's ComparableVersionTest numeric order.
+func TestCompare_Numbers(t *testing.T) {
+	checkOrder(t, []string{
+		"2.0", "2-1", "2.0.a", "2.0.0.a", "2.0.2", "2.0.123", "2.1.0", "2.1-a", "2.1b",
+		"2.1-c", "2.1-1", "2.1.0.1", "2.2", "2.123", "11.a2", "11.a11", "11.b2",
+		"11.b11", "11.m2", "11.m11", "11", "11.a", "11b", "11c", "11m",
+	})
+}
+
+// TestCompare_Equal checks aliases and normalization of null items.
+func TestCompare_Equal(t *testing.T) {
+	for _, pair := range [][2]string{
+		{"1", "1.0.0"},
+		{"1-0", "1"},
+		{"1.0.Final", "1"},
+		{"1-ga", "1.RELEASE"},
+		{"1a1", "1-alpha-1"},
+		{"1b2", "1-beta-2"},
+		{"1m3", "1-milestone-3"},
+		{"1cr", "1rc"},
+		{"1X", "1-x"},
+		{"1.0.0-00001", "1-1"},
+		{"12345678901234567890", "12345678901234567890.0"},
+	} {
+		if c := Compare(pair[0], pair[1]); c != 0 {
+			t.Errorf("Compare(%q, %q) = %d, want 0", pair[0], pair[1], c)
+		}
+	}
+}
+
+// TestSort checks sorting a semver.List with Maven ordering.
+func TestSort(t *testing.T) {
+	var ls semver.List
+	for _, s := range []string{"1.0.1", "1.0.0-SNAPSHOT", "1.0.0", "1.0.0-rc.1", "1.0.0+sp"} {
+		v, _ := semver.Parse(s)
+		ls = append(ls, v)
+	}
+
+	Sort(ls)
+
+	want := []string{"1.0.0-rc.1", "1.0.0-SNAPSHOT", "1.0.0", "1.0.0+sp", "1.0.1"}
+	for i, v := range ls {
+		if v.Original != want[i] {
+			t.Fatalf("Sort: got %v, want %v", ls, want)
+		}
+	}
+}
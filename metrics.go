@@ -0,0 +1,55 @@
+package semver
+
+import "strings"
+
+// MetricLabelInvalid is the MetricLabel value of invalid versions.
+const MetricLabelInvalid = "invalid"
+
+// MetricLabel renders v as a metrics label value with bounded cardinality:
+// "1", "1.2" or "1.2.3-rc.1" for GranularityMajor, GranularityMinor and
+// GranularityPatch. Only GranularityPatch keeps the prerelease, build
+// metadata and prefix are always dropped, the result is passed through
+// SanitizeLabel. Invalid versions all map to MetricLabelInvalid.
+func (v Semver) MetricLabel(g Granularity) string {
+	if !v.Valid {
+		return MetricLabelInvalid
+	}
+
+	switch g {
+	case GranularityMajor:
+		return v.Print(PrintPrefixNoV | PrintMajor)
+	case GranularityMinor:
+		return v.Print(PrintPrefixNoV | PrintMajor | PrintMinor)
+	}
+
+	return SanitizeLabel(v.Print(PrintPrefixNoV | PrintMaskRelease | PrintPrerelease))
+}
+
+// SanitizeLabel replaces every byte outside [A-Za-z0-9_.-] with '_', so
+// the result is safe as a label value for Prometheus, StatsD tags and
+// similar backends. Versions rendered by this package only need it for
+// unusual prerelease identifiers; it also suits Original of invalid input.
+func SanitizeLabel(s string) string {
+	i := strings.IndexFunc(s, func(r rune) bool { return !isLabelByte(r) })
+	if i < 0 {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	b.WriteString(s[:i])
+	for j := i; j < len(s); j++ {
+		if isLabelByte(rune(s[j])) {
+			b.WriteByte(s[j])
+		} else {
+			b.WriteByte('_')
+		}
+	}
+
+	return b.String()
+}
+
+// isLabelByte reports whether r is allowed by SanitizeLabel.
+func isLabelByte(r rune) bool {
+	return r < 0x80 && (isIdentChar(byte(r)) || r == '_' || r == '.')
+}
@@ -0,0 +1,39 @@
+package semver
+
+import "testing"
+
+func TestMetricLabel(t *testing.T) {
+	tests := []struct {
+		in                  string
+		major, minor, patch string
+	}{
+		{"v1.2.3-rc.1+sha.abc", "1", "1.2", "1.2.3-rc.1"},
+		{"V2", "2", "2.0", "2.0.0"},
+		{"2:1.0.0", "invalid", "invalid", "invalid"},
+		{"bad", "invalid", "invalid", "invalid"},
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.in)
+		for g, want := range []string{tt.major, tt.minor, tt.patch} {
+			if got := v.MetricLabel(Granularity(g)); got != want {
+				t.Errorf("MetricLabel(%q, %d) = %q, want %q", tt.in, g, got, want)
+			}
+		}
+	}
+}
+
+func TestSanitizeLabel(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"1.2.3-rc.1", "1.2.3-rc.1"},
+		{"1.2.3+b", "1.2.3_b"},
+		{"a b/c\"é", "a_b_c___"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := SanitizeLabel(tt.in); got != tt.want {
+			t.Errorf("SanitizeLabel(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
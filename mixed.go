@@ -0,0 +1,101 @@
+package semver
+
+import (
+	"slices"
+	"strings"
+)
+
+// MixedList is a list of raw tags mixing versions and other names
+// ("latest", "stable", "edge", "build-10"), as found in container registries.
+type MixedList []string
+
+// MixedOrder selects where MixedList.Sort places tags that are not versions.
+type MixedOrder uint8
+
+const (
+	OtherFirst MixedOrder = iota // non-version tags before all versions
+	OtherLast                    // non-version tags after all versions
+)
+
+// Sort sorts the tags in place: versions in ascending precedence (see
+// CompareForSort), other tags in natural order ("build-9" < "build-10") and
+// grouped before or after the versions as selected by order.
+func (ml MixedList) Sort(order MixedOrder) {
+	type entry struct {
+		s string
+		v Semver
+	}
+
+	entries := make([]entry, len(ml))
+	for i, s := range ml {
+		v, _ := Parse(s)
+		entries[i] = entry{s: s, v: v}
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		switch {
+		case a.v.Valid && b.v.Valid:
+			return CompareForSort(a.v, b.v)
+		case !a.v.Valid && !b.v.Valid:
+			return NaturalCompare(a.s, b.s)
+		case a.v.Valid == (order == OtherFirst):
+			return 1
+		}
+		return -1
+	})
+
+	for i, e := range entries {
+		ml[i] = e.s
+	}
+}
+
+// NaturalCompare compares strings treating runs of digits as numbers:
+// "build-9" < "build-10" < "edge". Returns -1, 0 or +1. Runs of equal value
+// ("07" and "7") are ordered by byte comparison of the whole strings.
+func NaturalCompare(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if isDigit(a[i]) && isDigit(b[j]) {
+			ie, je := i, j
+			for ie < len(a) && isDigit(a[ie]) {
+				ie++
+			}
+			for je < len(b) && isDigit(b[je]) {
+				je++
+			}
+
+			na := strings.TrimLeft(a[i:ie], "0")
+			nb := strings.TrimLeft(b[j:je], "0")
+			if len(na) != len(nb) {
+				if len(na) < len(nb) {
+					return -1
+				}
+				return 1
+			}
+			if r := strings.Compare(na, nb); r != 0 {
+				return r
+			}
+
+			i, j = ie, je
+			continue
+		}
+
+		if a[i] != b[j] {
+			if a[i] < b[j] {
+				return -1
+			}
+			return 1
+		}
+		i++
+		j++
+	}
+
+	switch {
+	case i < len(a):
+		return 1
+	case j < len(b):
+		return -1
+	}
+
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,45 @@
+package semver
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMixedList_Sort(t *testing.T) {
+	in := []string{"latest", "v1.10.0", "edge", "build-10", "1.2.0", "build-9", "stable", "v1.2.0-rc.1"}
+
+	ml := MixedList(append([]string(nil), in...))
+	ml.Sort(OtherFirst)
+	if got, want := strings.Join(ml, " "), "build-9 build-10 edge latest stable v1.2.0-rc.1 1.2.0 v1.10.0"; got != want {
+		t.Errorf("Sort(OtherFirst) = %q, want %q", got, want)
+	}
+
+	ml = MixedList(append([]string(nil), in...))
+	ml.Sort(OtherLast)
+	if got, want := strings.Join(ml, " "), "v1.2.0-rc.1 1.2.0 v1.10.0 build-9 build-10 edge latest stable"; got != want {
+		t.Errorf("Sort(OtherLast) = %q, want %q", got, want)
+	}
+}
+
+func TestNaturalCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"build-9", "build-10", -1},
+		{"build-10", "build-9", 1},
+		{"a2b10", "a2b9", 1},
+		{"abc", "abd", -1},
+		{"abc", "ab", 1},
+		{"07", "7", -1},
+		{"x", "x", 0},
+		{"10", "x", -1},
+		{"", "a", -1},
+	}
+
+	for _, tt := range tests {
+		if got := NaturalCompare(tt.a, tt.b); got != tt.want {
+			t.Errorf("NaturalCompare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
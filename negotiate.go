@@ -0,0 +1,53 @@
+package semver
+
+// Negotiate returns the highest version supported by both sides of a
+// client/server handshake: the highest server version of equal precedence
+// to some client version (build metadata is ignored). Invalid versions are
+// skipped. Returns (zero, false) if the lists have no version in common.
+func Negotiate(client, server List) (Semver, bool) {
+	supported := make(map[Key]struct{}, len(client))
+	for _, v := range client {
+		if v.Valid {
+			supported[v.Key()] = struct{}{}
+		}
+	}
+
+	var best Semver
+	for _, v := range server {
+		if !v.Valid {
+			continue
+		}
+		if _, ok := supported[v.Key()]; ok && (!best.Valid || v.IsGreater(best)) {
+			best = v
+		}
+	}
+
+	return best, best.Valid
+}
+
+// NegotiateConstraint intersects the version ranges two sides accept and
+// returns the result in the minimal form of Constraint.Simplify. Returns
+// false if no version satisfies both ranges.
+//
+// Like Simplify, the result is exact for CheckWith with IncludePrerelease;
+// the npm prerelease rule of Check may admit fewer prereleases.
+func NegotiateConstraint(clientRange, serverRange Constraint) (Constraint, bool) {
+	var out []interval
+	for _, a := range clientRange.intervals() {
+		for _, b := range serverRange.intervals() {
+			iv := a
+			if compareLo(b.lo, iv.lo) > 0 {
+				iv.lo = b.lo
+			}
+			if compareHi(b.hi, iv.hi) < 0 {
+				iv.hi = b.hi
+			}
+			if !iv.empty() {
+				out = append(out, iv)
+			}
+		}
+	}
+
+	out = mergeIntervals(out)
+	return intervalConstraint(out), len(out) > 0
+}
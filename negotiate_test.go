@@ -0,0 +1,46 @@
+package semver
+
+import "testing"
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		client, server []string
+		want           string
+	}{
+		{[]string{"1.0.0", "1.1.0", "2.0.0"}, []string{"v1.1.0", "v2.0.0+srv", "v3.0.0"}, "v2.0.0+srv"},
+		{[]string{"1.0", "bad"}, []string{"1.0.0", "bad"}, "1.0.0"},
+		{[]string{"2.0.0-rc.1"}, []string{"2.0.0-rc.1", "2.0.0"}, "2.0.0-rc.1"},
+		{[]string{"1.0.0"}, []string{"2.0.0"}, ""},
+		{nil, []string{"1.0.0"}, ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := Negotiate(mustList(tt.client...), mustList(tt.server...))
+		if ok != (tt.want != "") || got.Original != tt.want {
+			t.Errorf("Negotiate(%v, %v) = %q, %v, want %q", tt.client, tt.server, got.Original, ok, tt.want)
+		}
+	}
+}
+
+func TestNegotiateConstraint(t *testing.T) {
+	tests := []struct {
+		client, server string
+		want           string
+		ok             bool
+	}{
+		{"^1.2", ">=1.4.0 <3", ">=1.4.0 <2.0.0-0", true},
+		{"^1 || ^3", ">=1.5.0", ">=1.5.0 <2.0.0-0 || >=3.0.0 <4.0.0-0", true},
+		{"1.2.3", "~1.2", "1.2.3", true},
+		{"*", "<2", "<2.0.0-0", true},
+		{"^1", "^2", constraintNone, false},
+		{"<=1.0.0", ">=1.0.0", "1.0.0", true},
+		{"<1.0.0", ">=1.0.0", constraintNone, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := NegotiateConstraint(MustConstraint(tt.client), MustConstraint(tt.server))
+		if ok != tt.ok || got.String() != tt.want {
+			t.Errorf("NegotiateConstraint(%q, %q) = %q, %v, want %q, %v", tt.client, tt.server, got, ok, tt.want, tt.ok)
+		}
+	}
+}
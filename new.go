@@ -0,0 +1,106 @@
+package semver
+
+// New returns the release MAJOR.MINOR.PATCH without string formatting and
+// parsing; Original is rendered without prefix ("1.2.3").
+// Negative components yield an invalid Semver.
+func New(major, minor, patch int) Semver {
+	if major < 0 || minor < 0 || patch < 0 {
+		return Semver{}
+	}
+
+	v := Semver{
+		Major: major,
+		Minor: minor,
+		Patch: patch,
+		Flags: FlagHasMajor | FlagHasMinor | FlagHasPatch,
+		Valid: true,
+	}
+	v.Original = v.Print(PrintMaskDefault)
+
+	return v
+}
+
+// Builder constructs a version fluently:
+//
+//	v, ok := semver.NewBuilder(1, 2, 3).Pre("rc.1").Build("sha.abc").Version()
+//
+// Every step is validated; the first failure is sticky and makes Version
+// report false. Builder values are immutable, each step returns a copy.
+type Builder struct {
+	v  Semver
+	ok bool
+}
+
+// NewBuilder starts a Builder at the release MAJOR.MINOR.PATCH.
+func NewBuilder(major, minor, patch int) Builder {
+	v := New(major, minor, patch)
+	return Builder{v: v, ok: v.Valid}
+}
+
+// V renders the version with a leading 'v'.
+func (b Builder) V() Builder {
+	b.v.Flags |= FlagHasV
+	return b
+}
+
+// Pre sets the prerelease (without leading '-'), see Semver.WithPre.
+func (b Builder) Pre(pre string) Builder {
+	return b.apply(func(v Semver) (Semver, bool) { return v.WithPre(pre) })
+}
+
+// PreIdentifiers sets the prerelease from identifiers, see Semver.WithPrereleaseIdentifiers.
+func (b Builder) PreIdentifiers(ids ...string) Builder {
+	return b.apply(func(v Semver) (Semver, bool) { return v.WithPrereleaseIdentifiers(ids) })
+}
+
+// Build sets the build metadata (without leading '+'), see Semver.WithBuild.
+func (b Builder) Build(build string) Builder {
+	return b.apply(func(v Semver) (Semver, bool) { return v.WithBuild(build) })
+}
+
+// BuildIdentifiers sets the build metadata from identifiers, see Semver.WithBuildIdentifiers.
+func (b Builder) BuildIdentifiers(ids ...string) Builder {
+	return b.apply(func(v Semver) (Semver, bool) { return v.WithBuildIdentifiers(ids) })
+}
+
+// Version returns the built version. Returns (zero, false) if any step failed.
+func (b Builder) Version() (Semver, bool) {
+	if !b.ok {
+		return Semver{}, false
+	}
+
+	v := b.v
+	mask := PrintMaskDefault
+	if v.Flags&FlagHasV != 0 {
+		mask |= PrintPrefixV
+	}
+	v.Original = v.Print(mask)
+
+	return v, true
+}
+
+// MustVersion is like Version but panics if any step failed.
+func (b Builder) MustVersion() Semver {
+	v, ok := b.Version()
+	if !ok {
+		panic("semver: invalid version built from " + b.v.Original)
+	}
+
+	return v
+}
+
+// apply runs step on the current version unless an earlier step failed.
+func (b Builder) apply(step func(Semver) (Semver, bool)) Builder {
+	if !b.ok {
+		return b
+	}
+
+	nv, ok := step(b.v)
+	if !ok {
+		b.ok = false
+		return b
+	}
+	b.v = nv
+
+	return b
+}
@@ -0,0 +1,41 @@
+package semver
+
+import "testing"
+
+func TestNew(t *testing.T) {
+	v := New(1, 2, 3)
+	if !v.Valid || v.Original != "1.2.3" || v != MustParse("1.2.3") {
+		t.Fatalf("New(1, 2, 3) = %+v", v)
+	}
+	if New(1, -1, 0).Valid {
+		t.Fatalf("New accepted a negative component")
+	}
+}
+
+func TestBuilder(t *testing.T) {
+	v, ok := NewBuilder(1, 2, 3).Pre("rc.1").Build("sha.abc").Version()
+	if !ok || v.Original != "1.2.3-rc.1+sha.abc" || v != MustParse("1.2.3-rc.1+sha.abc") {
+		t.Fatalf("Version = %+v, %v", v, ok)
+	}
+
+	v = NewBuilder(2, 0, 0).V().PreIdentifiers("beta", "2").BuildIdentifiers("ci", "77").MustVersion()
+	if v.Original != "v2.0.0-beta.2+ci.77" || v != MustParse("v2.0.0-beta.2+ci.77") {
+		t.Fatalf("MustVersion = %+v", v)
+	}
+
+	// the first failure is sticky
+	b := NewBuilder(1, 0, 0).Pre("01").Build("ok")
+	if _, ok := b.Version(); ok {
+		t.Fatalf("Version succeeded after an invalid step")
+	}
+	if _, ok := NewBuilder(-1, 0, 0).Version(); ok {
+		t.Fatalf("Version succeeded for a negative component")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("MustVersion did not panic")
+		}
+	}()
+	b.MustVersion()
+}
@@ -0,0 +1,119 @@
+package semver
+
+import "strings"
+
+// NormalizeStep is a single transform applied by a Normalizer.
+type NormalizeStep uint8
+
+// Normalization steps, applied in the order given to NewNormalizer.
+const (
+	NormalizeTrimSpace       NormalizeStep = iota + 1 // " 1.2.3\n" -> "1.2.3"
+	NormalizeStripPrefix                              // "release-1.2.3" -> "1.2.3", see ParsePrefixed
+	NormalizeLowerV                                   // "V1.2.3" -> "v1.2.3"
+	NormalizeStripV                                   // "v1.2.3" -> "1.2.3"
+	NormalizeDropBuild                                // "1.2.3+b.5" -> "1.2.3"
+	NormalizeExpandShorthand                          // "1.2" -> "1.2.0"
+	NormalizeCoerceRevision                           // "1.2.3.4" -> "1.2.3"
+)
+
+// normalizeStepNames maps steps to their String form.
+var normalizeStepNames = [...]string{
+	NormalizeTrimSpace:       "trim-space",
+	NormalizeStripPrefix:     "strip-prefix",
+	NormalizeLowerV:          "lower-v",
+	NormalizeStripV:          "strip-v",
+	NormalizeDropBuild:       "drop-build",
+	NormalizeExpandShorthand: "expand-shorthand",
+	NormalizeCoerceRevision:  "coerce-revision",
+}
+
+// String returns a stable name of the step for audit logs.
+func (s NormalizeStep) String() string {
+	if int(s) < len(normalizeStepNames) && normalizeStepNames[s] != "" {
+		return normalizeStepNames[s]
+	}
+
+	return "unknown"
+}
+
+// Normalizer applies a chain of transforms to raw version strings and
+// reports which of them changed the input, for auditable ingestion.
+type Normalizer struct {
+	steps []NormalizeStep
+}
+
+// NewNormalizer returns a Normalizer applying steps in the given order.
+func NewNormalizer(steps ...NormalizeStep) Normalizer {
+	return Normalizer{steps: append([]NormalizeStep(nil), steps...)}
+}
+
+// NormalizeResult is the outcome of Normalizer.Normalize.
+type NormalizeResult struct {
+	// Input is the string given to Normalize.
+	Input string
+
+	// Applied lists the steps that changed the input, in order.
+	Applied []NormalizeStep
+
+	// Version is the parsed normalized string.
+	Version Semver
+}
+
+// Normalize runs the chain on s and parses the result.
+// ok is false if the normalized string is not a valid version; Applied is
+// filled either way.
+func (n Normalizer) Normalize(s string) (res NormalizeResult, ok bool) {
+	res.Input = s
+
+	cur := s
+	for _, step := range n.steps {
+		next := step.apply(cur)
+		if next != cur {
+			res.Applied = append(res.Applied, step)
+			cur = next
+		}
+	}
+
+	res.Version, ok = Parse(cur)
+	return res, ok
+}
+
+// apply runs the step on s, returning s unchanged when it does not apply.
+func (s NormalizeStep) apply(in string) string {
+	switch s {
+	case NormalizeTrimSpace:
+		return strings.TrimSpace(in)
+
+	case NormalizeStripPrefix:
+		if _, v, ok := ParsePrefixed(in); ok {
+			return v.Original
+		}
+
+	case NormalizeLowerV:
+		if strings.HasPrefix(in, "V") {
+			return "v" + in[1:]
+		}
+
+	case NormalizeStripV:
+		if strings.HasPrefix(in, "v") || strings.HasPrefix(in, "V") {
+			return in[1:]
+		}
+
+	case NormalizeDropBuild:
+		if i := strings.IndexByte(in, '+'); i >= 0 {
+			return in[:i]
+		}
+
+	case NormalizeExpandShorthand:
+		if v, ok := Parse(in); ok {
+			return v.Print(PrintMaskDefault)
+		}
+
+	case NormalizeCoerceRevision:
+		if v, ok := ParseWith(in, ParseOptions{AllowRevision: true}); ok && v.HasRevision() {
+			return v.Print(PrintMaskDefault &^ PrintRevision)
+		}
+	}
+
+	return in
+}
@@ -0,0 +1,57 @@
+package semver
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizer(t *testing.T) {
+	n := NewNormalizer(
+		NormalizeTrimSpace,
+		NormalizeCoerceRevision,
+		NormalizeStripPrefix,
+		NormalizeLowerV,
+		NormalizeDropBuild,
+		NormalizeExpandShorthand,
+	)
+
+	tests := []struct {
+		in      string
+		want    string
+		applied string
+		ok      bool
+	}{
+		{"1.2.3", "1.2.3", "", true},
+		{" V1.2+b.5\n", "v1.2.0", "trim-space lower-v drop-build expand-shorthand", true},
+		{"release-2.0.1", "2.0.1", "strip-prefix", true},
+		{"10.0.19045.3693", "10.0.19045", "coerce-revision", true},
+		{"V7", "v7.0.0", "lower-v expand-shorthand", true},
+		{"nightly ", "nightly", "trim-space", false},
+	}
+
+	for _, tt := range tests {
+		res, ok := n.Normalize(tt.in)
+
+		var applied []string
+		for _, s := range res.Applied {
+			applied = append(applied, s.String())
+		}
+
+		if ok != tt.ok || res.Input != tt.in || strings.Join(applied, " ") != tt.applied {
+			t.Errorf("Normalize(%q) = %v applied %q; want %v applied %q", tt.in, ok, applied, tt.ok, tt.applied)
+		}
+		if ok && res.Version.Original != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.in, res.Version.Original, tt.want)
+		}
+	}
+
+	if got := NewNormalizer(NormalizeStripV).steps[0].String(); got != "strip-v" {
+		t.Errorf("String = %q", got)
+	}
+	if res, _ := NewNormalizer(NormalizeStripV).Normalize("V1.0.0"); res.Version.Original != "1.0.0" {
+		t.Errorf("strip-v = %q", res.Version.Original)
+	}
+	if got := NormalizeStep(200).String(); got != "unknown" {
+		t.Errorf("unknown step String = %q", got)
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Package ocitags selects container image tags by semantic version.
+
+It reads OCI distribution tag lists ({"name": ..., "tags": [...]}), keeps
+tags that are valid versions, sorts them and answers MaxSatisfying queries.
+Non-version tags such as "latest", "stable" or commit hashes are skipped.
+Since tags can not contain '+', an '_' is read as the build metadata
+separator ("1.2.3_build.5"), the convention used by Helm for OCI charts.
+*/
+package ocitags
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/woozymasta/semver"
+)
+
+// TagList is the tag list document of the OCI distribution API.
+type TagList struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+// ParseTagList decodes a tag list document.
+func ParseTagList(r io.Reader) (TagList, error) {
+	var tl TagList
+	if err := json.NewDecoder(r).Decode(&tl); err != nil {
+		return TagList{}, fmt.Errorf("ocitags: decode tag list: %w", err)
+	}
+
+	return tl, nil
+}
+
+// Tag is a tag name with its parsed version.
+type Tag struct {
+	// Name is the tag exactly as published, to be used for pulls.
+	Name string
+
+	Version semver.Semver
+}
+
+// Versions returns the tags that are valid versions in ascending semver
+// order. Other tags are skipped.
+func Versions(tags []string) []Tag {
+	out := make([]Tag, 0, len(tags))
+	for _, name := range tags {
+		v, ok := semver.Parse(strings.Replace(name, "_", "+", 1))
+		if ok {
+			out = append(out, Tag{Name: name, Version: v})
+		}
+	}
+
+	slices.SortStableFunc(out, func(a, b Tag) int {
+		return semver.CompareForSort(a.Version, b.Version)
+	})
+
+	return out
+}
+
+// MaxSatisfying returns the highest tag whose version satisfies c.
+// Returns (zero, false) if no tag does.
+func MaxSatisfying(tags []string, c semver.Constraint) (Tag, bool) {
+	vs := Versions(tags)
+	for i := len(vs) - 1; i >= 0; i-- {
+		if c.Check(vs[i].Version) {
+			return vs[i], true
+		}
+	}
+
+	return Tag{}, false
+}
+
+// Fetcher lists the tags of a repository.
+type Fetcher interface {
+	Tags(ctx context.Context, repository string) ([]string, error)
+}
+
+// Resolve fetches the tags of repository and returns MaxSatisfying(tags, c).
+// Fails with semver.ErrNoMatch if no tag satisfies c.
+func Resolve(ctx context.Context, f Fetcher, repository string, c semver.Constraint) (Tag, error) {
+	tags, err := f.Tags(ctx, repository)
+	if err != nil {
+		return Tag{}, err
+	}
+
+	t, ok := MaxSatisfying(tags, c)
+	if !ok {
+		return Tag{}, fmt.Errorf("ocitags: %s: %w", repository, semver.ErrNoMatch)
+	}
+
+	return t, nil
+}
+
+// DefaultMaxPages is the page limit used when Registry.MaxPages is not set.
+const DefaultMaxPages = 1000
+
+// ErrPagination is returned by Registry.Tags for a Link header that points
+// to another host, revisits a page or exceeds the page limit.
+var ErrPagination = errors.New("ocitags: invalid pagination")
+
+// Registry is a Fetcher for the OCI distribution API (GET /v2/<name>/tags/list).
+// Paginated responses are followed through their Link headers, only on the
+// scheme and host of BaseURL, so credentials are never sent elsewhere.
+// Authentication is left to Client, e.g. a token-injecting RoundTripper.
+type Registry struct {
+	// Client performs the requests; nil means http.DefaultClient.
+	Client *http.Client
+
+	// BaseURL is the registry root, e.g. "https://ghcr.io".
+	BaseURL string
+
+	// MaxPages bounds the number of pages fetched per call; <= 0 means
+	// DefaultMaxPages.
+	MaxPages int
+}
+
+// linkNext extracts the target of a `Link: <...>; rel="next"` header.
+var linkNext = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="?next"?`)
+
+// Tags implements Fetcher.
+func (r Registry) Tags(ctx context.Context, repository string) ([]string, error) {
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	base, err := url.Parse(strings.TrimSuffix(r.BaseURL, "/") + "/v2/" + repository + "/tags/list")
+	if err != nil {
+		return nil, fmt.Errorf("ocitags: %w", err)
+	}
+
+	maxPages := r.MaxPages
+	if maxPages <= 0 {
+		maxPages = DefaultMaxPages
+	}
+
+	var tags []string
+	visited := make(map[string]bool)
+	for next := base; next != nil; {
+		if len(visited) == maxPages {
+			return nil, fmt.Errorf("%w: more than %d pages", ErrPagination, maxPages)
+		}
+		visited[next.String()] = true
+
+		tl, link, err := r.page(ctx, client, next)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tl.Tags...)
+
+		next = nil
+		if m := linkNext.FindStringSubmatch(link); m != nil {
+			if next, err = base.Parse(m[1]); err != nil {
+				return nil, fmt.Errorf("ocitags: bad Link header %q: %w", link, err)
+			}
+			switch {
+			case next.Scheme != base.Scheme || next.Host != base.Host:
+				return nil, fmt.Errorf("%w: next page %s is not on %s://%s", ErrPagination, next.Redacted(), base.Scheme, base.Host)
+			case visited[next.String()]:
+				return nil, fmt.Errorf("%w: next page %s was already fetched", ErrPagination, next.Redacted())
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// page fetches one page of a tag list and returns it with its Link header.
+func (r Registry) page(ctx context.Context, client *http.Client, u *url.URL) (TagList, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return TagList{}, "", fmt.Errorf("ocitags: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return TagList{}, "", fmt.Errorf("ocitags: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return TagList{}, "", fmt.Errorf("ocitags: GET %s: %s", u.Redacted(), resp.Status)
+	}
+
+	tl, err := ParseTagList(resp.Body)
+	return tl, resp.Header.Get("Link"), err
+}
@@ -0,0 +1,115 @@
+package ocitags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/woozymasta/semver"
+)
+
+func TestVersions(t *testing.T) {
+	tl, err := ParseTagList(strings.NewReader(
+		`{"name":"library/app","tags":["latest","1.2","1.10.0","1.2.1_build.7","sha-4f2a9c","v2.0.0-rc.1","stable"]}`,
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, tag := range Versions(tl.Tags) {
+		names = append(names, tag.Name)
+	}
+	if got := strings.Join(names, " "); got != "1.2 1.2.1_build.7 1.10.0 v2.0.0-rc.1" {
+		t.Fatalf("Versions = %q", got)
+	}
+
+	tag, ok := MaxSatisfying(tl.Tags, semver.MustConstraint("~1.2"))
+	if !ok || tag.Name != "1.2.1_build.7" || tag.Version.Build != "build.7" {
+		t.Fatalf("MaxSatisfying(~1.2) = %+v, %v", tag, ok)
+	}
+
+	if _, ok := MaxSatisfying(tl.Tags, semver.MustConstraint(">=3")); ok {
+		t.Fatalf("MaxSatisfying(>=3) succeeded")
+	}
+
+	if _, err := ParseTagList(strings.NewReader("{")); err == nil {
+		t.Fatalf("ParseTagList accepted malformed JSON")
+	}
+}
+
+func TestRegistry_Tags(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/team/app/tags/list" {
+			http.NotFound(w, r)
+			return
+		}
+
+		if r.URL.Query().Get("last") == "" {
+			w.Header().Set("Link", `</v2/team/app/tags/list?n=2&last=1.1.0>; rel="next"`)
+			fmt.Fprint(w, `{"name":"team/app","tags":["1.0.0","1.1.0"]}`)
+			return
+		}
+		fmt.Fprint(w, `{"name":"team/app","tags":["2.0.0","latest"]}`)
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	reg := Registry{BaseURL: srv.URL}
+
+	tags, err := reg.Tags(ctx, "team/app")
+	if err != nil || strings.Join(tags, " ") != "1.0.0 1.1.0 2.0.0 latest" {
+		t.Fatalf("Tags = %v, %v", tags, err)
+	}
+
+	tag, err := Resolve(ctx, reg, "team/app", semver.MustConstraint("^1"))
+	if err != nil || tag.Name != "1.1.0" {
+		t.Fatalf("Resolve(^1) = %+v, %v", tag, err)
+	}
+
+	if _, err := Resolve(ctx, reg, "team/app", semver.MustConstraint("^3")); !errors.Is(err, semver.ErrNoMatch) {
+		t.Fatalf("Resolve(^3) error = %v, want ErrNoMatch", err)
+	}
+	if _, err := reg.Tags(ctx, "missing"); err == nil {
+		t.Fatalf("Tags(missing) succeeded")
+	}
+}
+
+func TestRegistry_TagsPagination(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		switch r.URL.Path {
+		case "/v2/self/tags/list":
+			w.Header().Set("Link", `</v2/self/tags/list>; rel="next"`)
+		case "/v2/foreign/tags/list":
+			w.Header().Set("Link", `<https://evil.example/v2/foreign/tags/list?last=1.0.0>; rel="next"`)
+		case "/v2/endless/tags/list":
+			w.Header().Set("Link", fmt.Sprintf(`</v2/endless/tags/list?last=%d>; rel="next"`, hits))
+		}
+		fmt.Fprint(w, `{"tags":["1.0.0"]}`)
+	}))
+	defer srv.Close()
+
+	for _, tt := range []struct {
+		repository string
+		maxPages   int
+		want       int // requests made
+	}{
+		{"self", 0, 1},
+		{"foreign", 0, 1},
+		{"endless", 3, 3},
+	} {
+		hits = 0
+		reg := Registry{BaseURL: srv.URL, MaxPages: tt.maxPages}
+
+		_, err := reg.Tags(context.Background(), tt.repository)
+		if !errors.Is(err, ErrPagination) || hits != tt.want {
+			t.Errorf("%s: err = %v after %d requests, want ErrPagination after %d", tt.repository, err, hits, tt.want)
+		}
+	}
+}
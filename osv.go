@@ -0,0 +1,176 @@
+package semver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// OSV affected range types.
+const (
+	OSVTypeSemver    = "SEMVER"
+	OSVTypeEcosystem = "ECOSYSTEM"
+	OSVTypeGit       = "GIT" // not convertible, see ConstraintFromOSV
+)
+
+// OSVEvent is one entry of an OSV affected range's "events" list; exactly
+// one field is set.
+type OSVEvent struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+	Limit        string `json:"limit,omitempty"`
+}
+
+// OSVRange is an affected range of an OSV advisory ("affected[].ranges[]").
+// It decodes directly from OSV JSON.
+type OSVRange struct {
+	Type   string     `json:"type"`
+	Events []OSVEvent `json:"events"`
+}
+
+// osvEvent is a parsed OSVEvent; v is unset for introduced "0".
+type osvEvent struct {
+	v    Semver
+	kind byte // 'i'ntroduced, 'f'ixed, 'l'ast_affected, 'L'imit
+}
+
+// ConstraintFromOSV converts OSV affected ranges into a Constraint matching
+// every affected version:
+//
+//	introduced 0, fixed 1.2.3                        ->  <1.2.3
+//	introduced 1.0.0, fixed 1.0.5, introduced 2.0.0  ->  >=1.0.0 <1.0.5 || >=2.0.0
+//	introduced 1.1.0, last_affected 1.4.2            ->  >=1.1.0 <=1.4.2
+//
+// Events are evaluated in version order as the OSV schema prescribes and a
+// "limit" caps every interval of its range. Only SEMVER and ECOSYSTEM
+// ranges are accepted, the latter when its versions parse as semver; GIT
+// ranges and other types fail with an error wrapping ErrInvalidConstraint,
+// unparsable event versions with one wrapping ErrInvalidVersion.
+//
+// Affected prereleases lie inside the ranges, so check the result with
+// CheckWith and IncludePrerelease (as Affected does) rather than Check.
+func ConstraintFromOSV(ranges []OSVRange) (Constraint, error) {
+	var parts []string
+	for _, r := range ranges {
+		p, err := osvRangeParts(r)
+		if err != nil {
+			return Constraint{}, err
+		}
+		parts = append(parts, p...)
+	}
+
+	if len(parts) == 0 {
+		return MustConstraint(constraintNone), nil
+	}
+
+	return MustConstraint(strings.Join(parts, " || ")), nil
+}
+
+// Affected reports whether v is affected by any of the OSV ranges, see
+// ConstraintFromOSV. Prereleases inside a range are affected. Ranges that
+// can not be converted are ignored.
+func Affected(v Semver, ranges []OSVRange) bool {
+	for _, r := range ranges {
+		c, err := ConstraintFromOSV([]OSVRange{r})
+		if err == nil && c.CheckWith(v, CheckOptions{IncludePrerelease: true}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// osvRangeParts renders one OSV range as "||" alternatives.
+func osvRangeParts(r OSVRange) ([]string, error) {
+	if r.Type != OSVTypeSemver && r.Type != OSVTypeEcosystem {
+		return nil, fmt.Errorf("%w: unsupported OSV range type %q", ErrInvalidConstraint, r.Type)
+	}
+
+	events := make([]osvEvent, 0, len(r.Events))
+	var limits []string
+	for _, e := range r.Events {
+		kind, s := byte('i'), e.Introduced
+		switch {
+		case e.Fixed != "":
+			kind, s = 'f', e.Fixed
+		case e.LastAffected != "":
+			kind, s = 'l', e.LastAffected
+		case e.Limit != "":
+			kind, s = 'L', e.Limit
+		}
+
+		if kind == 'i' && s == "0" {
+			events = append(events, osvEvent{kind: kind})
+			continue
+		}
+		if kind == 'L' && s == "*" {
+			continue
+		}
+
+		v, ok := Parse(s)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidVersion, s)
+		}
+		if kind == 'L' {
+			limits = append(limits, "<"+osvPrint(v))
+			continue
+		}
+		events = append(events, osvEvent{v: v, kind: kind})
+	}
+
+	slices.SortStableFunc(events, func(a, b osvEvent) int {
+		switch {
+		case !a.v.Valid && !b.v.Valid:
+			return 0
+		case !a.v.Valid:
+			return -1
+		case !b.v.Valid:
+			return 1
+		}
+		return a.v.Compare(b.v)
+	})
+
+	var parts []string
+	emit := func(lo, hi string) {
+		terms := make([]string, 0, 2+len(limits))
+		if lo != "" {
+			terms = append(terms, lo)
+		}
+		if hi != "" {
+			terms = append(terms, hi)
+		}
+		terms = append(terms, limits...)
+		if len(terms) == 0 {
+			terms = append(terms, "*")
+		}
+		parts = append(parts, strings.Join(terms, " "))
+	}
+
+	affected, lo := false, ""
+	for _, e := range events {
+		switch {
+		case e.kind == 'i' && !affected:
+			affected, lo = true, ""
+			if e.v.Valid {
+				lo = ">=" + osvPrint(e.v)
+			}
+		case e.kind == 'f' && affected:
+			emit(lo, "<"+osvPrint(e.v))
+			affected = false
+		case e.kind == 'l' && affected:
+			emit(lo, "<="+osvPrint(e.v))
+			affected = false
+		}
+	}
+	if affected {
+		emit(lo, "")
+	}
+
+	return parts, nil
+}
+
+// osvPrint renders v for use in a constraint, without prefix and build.
+func osvPrint(v Semver) string {
+	return v.Print(PrintPrefixNoV | PrintMaskRelease | PrintPrerelease)
+}
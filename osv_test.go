@@ -0,0 +1,91 @@
+package semver
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestConstraintFromOSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		ranges  string
+		want    string
+		wantErr error
+	}{
+		{"fixed", `[{"type":"SEMVER","events":[{"introduced":"0"},{"fixed":"1.2.3"}]}]`, "<1.2.3", nil},
+		{"open", `[{"type":"SEMVER","events":[{"introduced":"0"}]}]`, "*", nil},
+		{
+			"unsorted",
+			`[{"type":"SEMVER","events":[{"introduced":"2.0.0"},{"fixed":"1.0.5"},{"introduced":"1.0.0"}]}]`,
+			">=1.0.0 <1.0.5 || >=2.0.0", nil,
+		},
+		{
+			"last affected",
+			`[{"type":"ECOSYSTEM","events":[{"introduced":"v1.1.0"},{"last_affected":"v1.4.2+b"}]}]`,
+			">=1.1.0 <=1.4.2", nil,
+		},
+		{
+			"limit",
+			`[{"type":"SEMVER","events":[{"introduced":"1.0.0"},{"limit":"1.9.0"}]}]`,
+			">=1.0.0 <1.9.0", nil,
+		},
+		{
+			"several ranges",
+			`[{"type":"SEMVER","events":[{"introduced":"0"},{"fixed":"0.5.0-rc.1"}]},
+			  {"type":"SEMVER","events":[{"introduced":"1.0.0"},{"fixed":"1.0.1"}]}]`,
+			"<0.5.0-rc.1 || >=1.0.0 <1.0.1", nil,
+		},
+		{"none", `[]`, "<0.0.0-0", nil},
+		{"git", `[{"type":"GIT","events":[{"introduced":"abc123"}]}]`, "", ErrInvalidConstraint},
+		{"bad version", `[{"type":"SEMVER","events":[{"introduced":"1.x"}]}]`, "", ErrInvalidVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ranges []OSVRange
+			if err := json.Unmarshal([]byte(tt.ranges), &ranges); err != nil {
+				t.Fatal(err)
+			}
+
+			c, err := ConstraintFromOSV(ranges)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || c.String() != tt.want {
+				t.Fatalf("ConstraintFromOSV = %q, %v; want %q", c.String(), err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAffected(t *testing.T) {
+	ranges := []OSVRange{
+		{Type: OSVTypeGit, Events: []OSVEvent{{Introduced: "0"}}},
+		{Type: OSVTypeSemver, Events: []OSVEvent{{Introduced: "1.0.0"}, {Fixed: "1.4.0"}}},
+		{Type: OSVTypeSemver, Events: []OSVEvent{{Introduced: "2.0.0-rc.1"}, {LastAffected: "2.1.0"}}},
+	}
+
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"0.9.0", false},
+		{"1.0.0", true},
+		{"1.4.0-beta", true},
+		{"1.4.0", false},
+		{"2.0.0-rc.2", true},
+		{"2.1.0", true},
+		{"2.1.1", false},
+		{"bad", false},
+	}
+	for _, tt := range tests {
+		v, _ := Parse(tt.in)
+		if got := Affected(v, ranges); got != tt.want {
+			t.Errorf("Affected(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
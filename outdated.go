@@ -0,0 +1,60 @@
+package semver
+
+// Upgrades lists the newest available upgrades of a dependency by category.
+// A field is the zero (invalid) Semver when no upgrade of that kind exists.
+type Upgrades struct {
+	// Current is the version in use.
+	Current Semver
+
+	// Patch is the newest release with the same MAJOR.MINOR.
+	Patch Semver
+
+	// Minor is the newest release with the same MAJOR and a greater MINOR.
+	Minor Semver
+
+	// Major is the newest release with a greater MAJOR.
+	Major Semver
+}
+
+// Outdated reports, per dependency name, the newest patch, minor and major
+// upgrades of current found in available (`npm outdated` style).
+// Only stable releases are upgrade candidates. Names with an invalid current
+// version or without any upgrade are omitted from the result.
+func Outdated(current map[string]Semver, available map[string]List) map[string]Upgrades {
+	out := make(map[string]Upgrades)
+
+	for name, cur := range current {
+		if !cur.Valid {
+			continue
+		}
+
+		u := Upgrades{Current: cur}
+		found := false
+		for _, v := range available[name] {
+			if !v.Valid || v.HasPre() || !v.IsGreater(cur) {
+				continue
+			}
+
+			var slot *Semver
+			switch {
+			case v.Major != cur.Major:
+				slot = &u.Major
+			case v.Minor != cur.Minor:
+				slot = &u.Minor
+			default:
+				slot = &u.Patch
+			}
+
+			if !slot.Valid || v.IsGreater(*slot) {
+				*slot = v
+			}
+			found = true
+		}
+
+		if found {
+			out[name] = u
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,34 @@
+package semver
+
+import "testing"
+
+func TestOutdated(t *testing.T) {
+	current := map[string]Semver{
+		"web":    MustParse("1.2.3"),
+		"db":     MustParse("2.0.0"),
+		"cache":  MustParse("1.0.0-rc.1"),
+		"broken": {},
+	}
+	available := map[string]List{
+		"web":    mustList("1.2.3", "1.2.5", "1.2.4", "1.3.0", "1.4.1", "2.0.0", "3.0.0-rc.1", "bad"),
+		"db":     mustList("1.9.0", "2.0.0", "2.1.0-rc.1"),
+		"cache":  mustList("1.0.0"),
+		"broken": mustList("9.9.9"),
+	}
+
+	got := Outdated(current, available)
+	if len(got) != 2 {
+		t.Fatalf("Outdated returned %d entries, want 2: %+v", len(got), got)
+	}
+
+	web := got["web"]
+	if web.Current.Original != "1.2.3" || web.Patch.Original != "1.2.5" ||
+		web.Minor.Original != "1.4.1" || web.Major.Original != "2.0.0" {
+		t.Errorf("web = %+v", web)
+	}
+
+	cache := got["cache"]
+	if cache.Patch.Original != "1.0.0" || cache.Minor.Valid || cache.Major.Valid {
+		t.Errorf("cache = %+v", cache)
+	}
+}
@@ -0,0 +1,130 @@
+package semver
+
+import "strings"
+
+// paddedRelease terminates the padded key of a release. It sorts after '-',
+// which starts a prerelease, so a release orders after its prereleases.
+const paddedRelease = '~'
+
+// Padded returns a fixed-width key whose byte order matches precedence, for
+// file names and object store keys:
+//
+//	1.2.3-rc.4   -> "00001.00002.00003-rc.00004"   (width 5)
+//	1.2.3        -> "00001.00002.00003~"
+//
+// MAJOR, MINOR, PATCH and numeric prerelease identifiers are zero-padded to
+// width digits; a release ends with '~'. Build metadata is dropped.
+// Returns "" if v is invalid, a number is wider than width, or a prerelease
+// identifier contains '-' or is alphanumeric but starts with a digit
+// ("1a" would sort among the padded numbers); either would break the byte
+// order.
+// ParsePadded decodes the key.
+func (v *Semver) Padded(width int) string {
+	if !v.Valid || digits10(v.Major) > width || digits10(v.Minor) > width || digits10(v.Patch) > width {
+		return ""
+	}
+
+	pre := v.Prerelease
+	if v.Flags&FlagHasPre == 0 {
+		pre = ""
+	}
+	if strings.IndexByte(pre, '-') >= 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.Grow(3*width + 3 + len(pre) + 4*width)
+	writePadded(&b, v.Major, width)
+	b.WriteByte('.')
+	writePadded(&b, v.Minor, width)
+	b.WriteByte('.')
+	writePadded(&b, v.Patch, width)
+
+	if pre == "" {
+		b.WriteByte(paddedRelease)
+		return b.String()
+	}
+
+	b.WriteByte('-')
+	for i, rest := 0, pre; rest != ""; i++ {
+		var id string
+		id, rest = nextIdent(rest)
+		if i > 0 {
+			b.WriteByte('.')
+		}
+
+		if !isNum(id) {
+			if isDigit(id[0]) {
+				return ""
+			}
+			b.WriteString(id)
+			continue
+		}
+		if len(id) > width {
+			return ""
+		}
+		for j := len(id); j < width; j++ {
+			b.WriteByte('0')
+		}
+		b.WriteString(id)
+	}
+
+	return b.String()
+}
+
+// ParsePadded decodes a key produced by Padded. Padding zeros are removed;
+// Original is rendered without prefix ("1.2.3-rc.4").
+// Returns (zero, false) for malformed keys.
+func ParsePadded(s string) (Semver, bool) {
+	var core, pre string
+	switch {
+	case strings.HasSuffix(s, string(paddedRelease)):
+		core = s[:len(s)-1]
+	case strings.IndexByte(s, '-') >= 0:
+		core, pre, _ = strings.Cut(s, "-")
+		if pre == "" {
+			return Semver{Original: s}, false
+		}
+	default:
+		return Semver{Original: s}, false
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return Semver{Original: s}, false
+	}
+	for i, p := range parts {
+		parts[i] = unpad(p)
+	}
+
+	out := strings.Join(parts, ".")
+	if pre != "" {
+		ids := strings.Split(pre, ".")
+		for i, id := range ids {
+			ids[i] = unpad(id)
+		}
+		out += "-" + strings.Join(ids, ".")
+	}
+
+	v, ok := Parse(out)
+	if !ok {
+		return Semver{Original: s}, false
+	}
+
+	return v, true
+}
+
+// unpad strips padding zeros from a numeric string ("00012" -> "12").
+// Non-numeric strings are returned unchanged.
+func unpad(s string) string {
+	if s == "" || !isNum(s) {
+		return s
+	}
+
+	s = strings.TrimLeft(s, "0")
+	if s == "" {
+		return "0"
+	}
+
+	return s
+}
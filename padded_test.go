@@ -0,0 +1,64 @@
+package semver
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPadded(t *testing.T) {
+	tests := []struct {
+		in    string
+		width int
+		want  string
+	}{
+		{"1.2.3-rc.4", 5, "00001.00002.00003-rc.00004"},
+		{"v1.2", 3, "001.002.000~"},
+		{"1.2.3+build", 1, "1.2.3~"},
+		{"1.0.0-alpha.beta.11", 2, "01.00.00-alpha.beta.11"},
+		{"100.0.0", 2, ""},
+		{"1.0.0-rc.100", 2, ""},
+		{"1.0.0-x-y", 5, ""},
+		{"1.2.3-00000a", 5, ""},
+		{"1.2.3-rc.10a", 5, ""},
+		{"1.2.3-rc.a10", 5, "00001.00002.00003-rc.a10"},
+		{"bad", 5, ""},
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.in)
+		if got := v.Padded(tt.width); got != tt.want {
+			t.Errorf("%q.Padded(%d) = %q, want %q", tt.in, tt.width, got, tt.want)
+		}
+	}
+}
+
+// TestPadded_Order checks that byte order of keys matches precedence and
+// that ParsePadded inverts Padded.
+func TestPadded_Order(t *testing.T) {
+	ls := mustList(
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta",
+		"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1",
+		"1.2.0", "1.10.0", "2.0.0-0", "2.0.0-2", "2.0.0-A", "2.0.0-a", "2.0.0",
+	)
+
+	keys := make([]string, len(ls))
+	for i := range ls {
+		keys[i] = ls[i].Padded(4)
+
+		back, ok := ParsePadded(keys[i])
+		if !ok || !back.IsEqual(ls[i]) {
+			t.Errorf("ParsePadded(%q) = %q, %v; want %q", keys[i], back.Original, ok, ls[i].Original)
+		}
+	}
+
+	if !slices.IsSorted(keys) {
+		t.Errorf("keys are not in byte order:\n%s", strings.Join(keys, "\n"))
+	}
+
+	for _, bad := range []string{"", "1.2.3", "1.2~", "a.b.c~", "0001.0002.0003-"} {
+		if _, ok := ParsePadded(bad); ok {
+			t.Errorf("ParsePadded(%q) accepted malformed key", bad)
+		}
+	}
+}
@@ -1,5 +1,19 @@
 package semver
 
+import "strconv"
+
+// ParseOptions enables non-SemVer input forms in ParseWith.
+// The zero value is the strict grammar of Parse.
+type ParseOptions struct {
+	// AllowRevision accepts a fourth numeric component ("1.2.3.4", Windows
+	// file versions, vendor firmware) into Semver.Revision.
+	AllowRevision bool
+
+	// AllowEpoch accepts a distro-style "EPOCH:" prefix ("2:1.4.0",
+	// "1:v2.0.0") into Semver.Epoch.
+	AllowEpoch bool
+}
+
 // Parse parses a version string into Semver.
 // It accepts an optional leading 'v'/'V' and the shorthand forms "MAJOR" and
 // "MAJOR.MINOR" (which normalize to ".0.0" and ".0").
@@ -7,20 +21,33 @@ package semver
 // Numeric components must fit into the host int size; otherwise the input
 // is rejected as invalid.
 func Parse(s string) (Semver, bool) {
+	return ParseWith(s, ParseOptions{})
+}
+
+// ParseWith is like Parse but additionally accepts the forms enabled in opts.
+func ParseWith(s string, opts ParseOptions) (Semver, bool) {
 	if s == "" {
 		return Semver{Original: s, Valid: false}, false
 	}
 	orig := s
 	flags := Flags(0)
 
+	// epoch (optional, ParseOptions.AllowEpoch)
+	vOffset, epoch := 0, 0
+	if opts.AllowEpoch {
+		if e, n, ok := parseInt(orig, 0); ok && n < len(orig) && orig[n] == ':' {
+			epoch, vOffset = e, n+1
+			flags |= FlagHasEpoch
+		}
+	}
+
 	// Compute raw view (skip optional leading 'v'/'V') via offset, no extra field in struct.
-	vOffset := 0
-	if orig[0] == 'v' || orig[0] == 'V' {
+	if vOffset < len(orig) && (orig[vOffset] == 'v' || orig[vOffset] == 'V') {
 		flags |= FlagHasV
-		if len(orig) == 1 {
-			return Semver{Original: orig, Valid: false}, false
-		}
-		vOffset = 1
+		vOffset++
+	}
+	if vOffset == len(orig) {
+		return Semver{Original: orig, Valid: false}, false
 	}
 	raw := orig[vOffset:]
 
@@ -34,7 +61,7 @@ func Parse(s string) (Semver, bool) {
 	flags |= FlagHasMajor
 	i = n
 
-	min, pat := 0, 0
+	min, pat, rev := 0, 0, 0
 
 	// minor (optional shorthand)
 	if i < len(raw) && raw[i] == '.' {
@@ -57,6 +84,18 @@ func Parse(s string) (Semver, bool) {
 			pat = pp
 			i = n3
 			flags |= FlagHasPatch
+
+			// revision (optional, ParseOptions.AllowRevision)
+			if opts.AllowRevision && i < len(raw) && raw[i] == '.' {
+				i++
+				rr, n4, ok := parseInt(raw, i)
+				if !ok {
+					return Semver{Original: orig, Valid: false}, false
+				}
+				rev = rr
+				i = n4
+				flags |= FlagHasRevision
+			}
 		}
 	}
 
@@ -95,9 +134,11 @@ func Parse(s string) (Semver, bool) {
 
 	v := Semver{
 		Original:   orig,
+		Epoch:      epoch,
 		Major:      maj,
 		Minor:      min,
 		Patch:      pat,
+		Revision:   rev,
 		Prerelease: pre,
 		Build:      build,
 		Flags:      flags,
@@ -107,6 +148,24 @@ func Parse(s string) (Semver, bool) {
 	return v, true
 }
 
+// MustParse is like Parse but panics if s is not a valid version.
+// It simplifies declaring versions as package-level variables.
+func MustParse(s string) Semver {
+	return Must(Parse(s))
+}
+
+// Must returns v if ok is true and panics otherwise. It wraps calls
+// returning (Semver, bool) such as Parse, BumpPatch or WithPre:
+//
+//	var next = semver.Must(semver.MustParse("1.2.3").WithPre("rc.1"))
+func Must(v Semver, ok bool) Semver {
+	if !ok {
+		panic("semver: invalid version " + strconv.Quote(v.Original))
+	}
+
+	return v
+}
+
 // parseInt parses a non-negative int at raw[i:], SemVer rules (no leading zeros for multi-digit).
 // Returns value, next index, ok.
 func parseInt(raw string, i int) (val int, next int, ok bool) {
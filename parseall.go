@@ -0,0 +1,54 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseAllOptions configures ParseAllWith.
+type ParseAllOptions struct {
+	// Parse selects the accepted input forms, see ParseWith.
+	Parse ParseOptions
+
+	// Arena copies all lines into one contiguous string before parsing, so
+	// Original, Prerelease and Build of every result slice that single
+	// allocation instead of the caller's strings. This cuts allocator
+	// pressure and improves locality when ingesting large tag dumps, and
+	// lets the input slice be released. The arena stays alive as long as
+	// any result does; call Compact on the few versions kept long-term.
+	Arena bool
+}
+
+// ParseAll parses lines in bulk, see ParseAllWith.
+func ParseAll(lines []string) (List, []error) {
+	return ParseAllWith(lines, ParseAllOptions{})
+}
+
+// ParseAllWith parses every line with opts.Parse. The result has one
+// element per line, in input order, so ls[i] corresponds to lines[i];
+// invalid lines are kept as invalid versions. Each invalid line also yields
+// an error wrapping ErrInvalidVersion that names its line number (1-based);
+// errs is nil when all lines are valid.
+func ParseAllWith(lines []string, opts ParseAllOptions) (ls List, errs []error) {
+	if opts.Arena {
+		arena := strings.Join(lines, "")
+		src := make([]string, len(lines))
+		off := 0
+		for i, s := range lines {
+			src[i] = arena[off : off+len(s)]
+			off += len(s)
+		}
+		lines = src
+	}
+
+	ls = make(List, len(lines))
+	for i, s := range lines {
+		v, ok := ParseWith(s, opts.Parse)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w: line %d: %q", ErrInvalidVersion, i+1, s))
+		}
+		ls[i] = v
+	}
+
+	return ls, errs
+}
@@ -0,0 +1,50 @@
+package semver
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+)
+
+func TestParseAll(t *testing.T) {
+	lines := []string{"v1.2.3-rc.1+b", "bad", "2.0.0", ""}
+
+	for _, arena := range []bool{false, true} {
+		ls, errs := ParseAllWith(lines, ParseAllOptions{Arena: arena})
+		if len(ls) != len(lines) {
+			t.Fatalf("arena=%v: len = %d, want %d", arena, len(ls), len(lines))
+		}
+		for i, s := range lines {
+			want, _ := Parse(s)
+			if ls[i] != want {
+				t.Errorf("arena=%v: ls[%d] = %+v, want %+v", arena, i, ls[i], want)
+			}
+		}
+
+		if len(errs) != 2 {
+			t.Fatalf("arena=%v: errs = %v, want 2", arena, errs)
+		}
+		for _, err := range errs {
+			if !errors.Is(err, ErrInvalidVersion) {
+				t.Errorf("arena=%v: %v does not wrap ErrInvalidVersion", arena, err)
+			}
+		}
+		if got, want := errs[0].Error(), `semver: invalid version: line 2: "bad"`; got != want {
+			t.Errorf("arena=%v: err = %q, want %q", arena, got, want)
+		}
+	}
+
+	if _, errs := ParseAll([]string{"1.0.0", "1.1"}); errs != nil {
+		t.Errorf("ParseAll valid: errs = %v, want nil", errs)
+	}
+}
+
+func TestParseAllArenaShared(t *testing.T) {
+	ls, _ := ParseAllWith([]string{"1.0.0", "2.0.0-rc.1"}, ParseAllOptions{Arena: true})
+
+	a := unsafe.StringData(ls[0].Original)
+	b := unsafe.StringData(ls[1].Original)
+	if uintptr(unsafe.Pointer(b))-uintptr(unsafe.Pointer(a)) != uintptr(len(ls[0].Original)) {
+		t.Errorf("arena results are not contiguous")
+	}
+}
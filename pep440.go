@@ -0,0 +1,253 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// pep440Pre maps PEP 440 prerelease spellings to semver prerelease labels.
+var pep440Pre = map[string]string{
+	"a": "alpha", "alpha": "alpha",
+	"b": "beta", "beta": "beta",
+	"c": "rc", "rc": "rc", "pre": "rc", "preview": "rc",
+}
+
+// pep440Short maps semver prerelease labels to PEP 440 pre-release markers.
+var pep440Short = map[string]string{"alpha": "a", "beta": "b", "rc": "rc"}
+
+// ToPEP440 renders v as a Python PEP 440 version string.
+//
+// Mappings (lossy where PEP 440 has no equivalent):
+//   - "1.2.3-alpha.1" / "-beta.2" / "-rc.3" -> "1.2.3a1" / "1.2.3b2" / "1.2.3rc3";
+//     "a", "b", "c", "pre" and "preview" spellings are accepted too;
+//   - "1.2.3-dev.4" -> "1.2.3.dev4", "1.2.3-rc.1.dev.2" -> "1.2.3rc1.dev2";
+//   - other prereleases become a dev release numbered by their trailing
+//     numeric identifier ("1.2.3-nightly.7" -> "1.2.3.dev7");
+//   - build "post.N" -> ".postN", any other build becomes the local
+//     version label ("+meta-1" -> "+meta.1", lowercased);
+//   - an Epoch is rendered as "N!" ("2:1.2.3" -> "2!1.2.3").
+//
+// The 'v' prefix is never rendered. Empty for invalid versions.
+func (v Semver) ToPEP440() string {
+	if !v.Valid {
+		return ""
+	}
+
+	var b strings.Builder
+	if v.Epoch != 0 {
+		b.WriteString(strconv.Itoa(v.Epoch) + "!")
+	}
+	b.WriteString(v.Print(PrintPrefixNoV | PrintMaskRelease))
+
+	if v.HasPre() {
+		b.WriteString(pep440PreSuffix(strings.Split(strings.ToLower(v.Prerelease), ".")))
+	}
+
+	if v.HasBuild() {
+		build := strings.ToLower(v.Build)
+		if post := strings.TrimPrefix(build, "post."); post != build && isNum(post) {
+			b.WriteString(".post" + post)
+		} else {
+			b.WriteByte('+')
+			b.WriteString(strings.ReplaceAll(build, "-", "."))
+		}
+	}
+
+	return b.String()
+}
+
+// FromPEP440 parses a Python PEP 440 version string into Semver.
+//
+// Accepted form (case-insensitive, with PEP 440 normalization of
+// separators and spellings):
+//
+//	[v][N!]N[.N[.N]][{a|b|rc}N][.postN][.devN][+local]
+//
+// Mappings (lossy where SemVer has no equivalent):
+//   - pre-releases become "alpha.N", "beta.N" or "rc.N";
+//   - ".devN" becomes prerelease "dev.N" (appended to a pre-release
+//     if there is one); unlike PEP 440, dev releases therefore sort after
+//     the pre-releases they precede;
+//   - ".postN" becomes build metadata "post.N" and does not affect ordering;
+//   - the local label becomes build metadata; post and local are joined
+//     with '.' when both are present;
+//   - a non-zero epoch "N!" becomes Epoch, as ParseWith with AllowEpoch
+//     would parse "N:..."; a zero epoch is dropped;
+//   - a release of one or two components is padded to three when a
+//     suffix follows ("1.0rc1" -> "1.0.0-rc.1");
+//   - more than three release components are rejected because their
+//     ordering can not be preserved.
+func FromPEP440(s string) (Semver, bool) {
+	in := strings.ToLower(strings.TrimSpace(s))
+	in = strings.TrimPrefix(in, "v")
+
+	// epoch
+	epoch := ""
+	if i := strings.IndexByte(in, '!'); i >= 0 {
+		if i == 0 || !isNum(in[:i]) {
+			return Semver{Original: s, Valid: false}, false
+		}
+		epoch = strings.TrimLeft(in[:i], "0")
+		in = in[i+1:]
+	}
+
+	// local version label
+	local := ""
+	if i := strings.IndexByte(in, '+'); i >= 0 {
+		in, local = in[:i], strings.NewReplacer("_", ".", "-", ".").Replace(in[i+1:])
+		if local == "" {
+			return Semver{Original: s, Valid: false}, false
+		}
+	}
+
+	// release segment
+	i := 0
+	var release []string
+	for {
+		j := i
+		for j < len(in) && in[j] >= '0' && in[j] <= '9' {
+			j++
+		}
+		if j == i {
+			return Semver{Original: s, Valid: false}, false
+		}
+		release = append(release, strings.TrimLeft(in[i:j], "0"))
+		i = j
+		if i+1 < len(in) && in[i] == '.' && in[i+1] >= '0' && in[i+1] <= '9' {
+			i++
+			continue
+		}
+		break
+	}
+	if len(release) > 3 {
+		return Semver{Original: s, Valid: false}, false
+	}
+	for k, r := range release {
+		if r == "" {
+			release[k] = "0"
+		}
+	}
+
+	var pre, post, dev string
+	var hasPre, hasPost, hasDev bool
+	rest := in[i:]
+	for rest != "" {
+		rest = strings.TrimLeft(rest, ".-_")
+		label := leadingAlpha(rest)
+		num, tail := pep440Num(rest[len(label):])
+
+		switch {
+		case label == "" && !hasPre && !hasPost && !hasDev && num != "" && strings.HasPrefix(in[i:], "-"):
+			// implicit post release "1.0-1"
+			post, hasPost = num, true
+		case pep440Pre[label] != "" && !hasPre && !hasPost && !hasDev:
+			pre, hasPre = pep440Pre[label]+"."+orZero(num), true
+		case (label == "post" || label == "rev" || label == "r") && !hasPost && !hasDev:
+			post, hasPost = orZero(num), true
+		case label == "dev" && !hasDev:
+			dev, hasDev = orZero(num), true
+		default:
+			return Semver{Original: s, Valid: false}, false
+		}
+		rest = tail
+	}
+
+	if hasPre || hasPost || hasDev || local != "" {
+		for len(release) < 3 {
+			release = append(release, "0")
+		}
+	}
+
+	out := strings.Join(release, ".")
+	switch {
+	case hasPre && hasDev:
+		out += "-" + pre + ".dev." + dev
+	case hasPre:
+		out += "-" + pre
+	case hasDev:
+		out += "-dev." + dev
+	}
+
+	var build []string
+	if hasPost {
+		build = append(build, "post."+post)
+	}
+	if local != "" {
+		build = append(build, local)
+	}
+	if len(build) > 0 {
+		out += "+" + strings.Join(build, ".")
+	}
+
+	if epoch != "" {
+		return ParseWith(epoch+":"+out, ParseOptions{AllowEpoch: true})
+	}
+
+	return Parse(out)
+}
+
+// pep440PreSuffix renders semver prerelease identifiers as PEP 440
+// pre-release and/or dev release suffix (see ToPEP440).
+func pep440PreSuffix(ids []string) string {
+	suffix := ""
+	rest := ids
+	if label := pep440Short[pep440Pre[ids[0]]]; label != "" {
+		switch {
+		case len(ids) == 1:
+			return label + "0"
+		case isNum(ids[1]):
+			suffix, rest = label+ids[1], ids[2:]
+		}
+	}
+
+	switch {
+	case len(rest) == 0:
+		return suffix
+	case rest[0] == "dev" && len(rest) == 1:
+		return suffix + ".dev0"
+	case rest[0] == "dev" && len(rest) == 2 && isNum(rest[1]):
+		return suffix + ".dev" + rest[1]
+	}
+
+	// not representable: dev release numbered by the trailing number
+	num := "0"
+	if last := ids[len(ids)-1]; len(ids) > 1 && isNum(last) {
+		num = last
+	}
+
+	return ".dev" + num
+}
+
+// leadingAlpha returns the leading run of ASCII lowercase letters of s.
+func leadingAlpha(s string) string {
+	i := 0
+	for i < len(s) && s[i] >= 'a' && s[i] <= 'z' {
+		i++
+	}
+
+	return s[:i]
+}
+
+// pep440Num splits an optional separator-prefixed number off s and returns
+// it without leading zeros ("" if absent) together with the remainder.
+func pep440Num(s string) (num, rest string) {
+	t := strings.TrimLeft(s, ".-_")
+	j := 0
+	for j < len(t) && t[j] >= '0' && t[j] <= '9' {
+		j++
+	}
+	if j == 0 {
+		return "", s
+	}
+
+	return orZero(strings.TrimLeft(t[:j], "0")), t[j:]
+}
+
+// orZero returns "0" for an empty numeric string.
+func orZero(n string) string {
+	if n == "" {
+		return "0"
+	}
+
+	return n
+}
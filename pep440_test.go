@@ -0,0 +1,84 @@
+package semver
+
+import "testing"
+
+// TestToPEP440 checks semver to PEP 440 rendering.
+func TestToPEP440(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"v1.2.3", "1.2.3"},
+		{"1.2", "1.2.0"},
+		{"1.2.3-alpha.1", "1.2.3a1"},
+		{"1.2.3-b.2", "1.2.3b2"},
+		{"1.2.3-rc", "1.2.3rc0"},
+		{"1.2.3-rc.1.dev.2", "1.2.3rc1.dev2"},
+		{"1.2.3-dev.4", "1.2.3.dev4"},
+		{"1.2.3-nightly.7", "1.2.3.dev7"},
+		{"1.2.3+post.1", "1.2.3.post1"},
+		{"1.2.3-rc.1+Meta-1", "1.2.3rc1+meta.1"},
+		{"bad", ""},
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.in)
+		if got := v.ToPEP440(); got != tt.want {
+			t.Errorf("ToPEP440(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+// TestFromPEP440 checks PEP 440 parsing, normalization and rejections.
+func TestFromPEP440(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"1.2.3", "1.2.3"},
+		{"v1.2", "1.2.0"},
+		{"0!1.0", "1.0.0"},
+		{"1.2.3rc1", "1.2.3-rc.1"},
+		{"1.2.3-RC.1", "1.2.3-rc.1"},
+		{"1.2.3alpha", "1.2.3-alpha.0"},
+		{"1.2.3c2", "1.2.3-rc.2"},
+		{"1.2.3.post1", "1.2.3+post.1"},
+		{"1.2.3-1", "1.2.3+post.1"},
+		{"1.2.3.dev4", "1.2.3-dev.4"},
+		{"1.2.3b1.dev2", "1.2.3-beta.1.dev.2"},
+		{"01.02.03", "1.2.3"},
+		{"1.2.3+ubuntu_1", "1.2.3+ubuntu.1"},
+		{"1.2.3.post1+local", "1.2.3+post.1.local"},
+		{"1.0rc1", "1.0.0-rc.1"},
+		{"1.0.post1", "1.0.0+post.1"},
+		{"1.0.dev1", "1.0.0-dev.1"},
+		{"1.0+local", "1.0.0+local"},
+		{"1rc1", "1.0.0-rc.1"},
+		{"1.0a2.dev3", "1.0.0-alpha.2.dev.3"},
+		{"1!1.0", "1.0.0"},
+		{"02!1.0rc1", "1.0.0-rc.1"},
+
+		{"x!1.0", ""},
+		{"1.2.3.4", ""},
+		{"1.2.3foo", ""},
+		{"1.2.3.dev1rc1", ""},
+		{"1.2.3+", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		v, ok := FromPEP440(tt.in)
+		if ok != (tt.want != "") {
+			t.Errorf("FromPEP440(%q) ok=%v, want %v", tt.in, ok, tt.want != "")
+			continue
+		}
+		if ok && v.SemVer() != tt.want {
+			t.Errorf("FromPEP440(%q) = %q, want %q", tt.in, v.SemVer(), tt.want)
+		}
+	}
+
+	v, ok := FromPEP440("2!1.0rc1")
+	if !ok || v.Epoch != 2 || !v.HasEpoch() || v.Original != "2:1.0.0-rc.1" {
+		t.Fatalf("FromPEP440(2!1.0rc1) = %+v, %v", v, ok)
+	}
+	if !v.IsGreater(MustParse("9.0.0")) {
+		t.Errorf("epoch must dominate ordering")
+	}
+	if got := v.ToPEP440(); got != "2!1.0.0rc1" {
+		t.Errorf("ToPEP440 of epoch version = %q", got)
+	}
+}
@@ -0,0 +1,104 @@
+package semver
+
+import "slices"
+
+// SupportPolicy describes a support window over published releases, e.g.
+// "latest 3 minors of each of the 2 newest majors":
+//
+//	SupportPolicy{Majors: 2, MinorsPerMajor: 3}
+//
+// Windows are computed from stable releases in the available list only;
+// prereleases never open a release line. A zero field means "no limit".
+type SupportPolicy struct {
+	// Majors is the number of newest MAJOR lines supported.
+	Majors int
+
+	// MinorsPerMajor is the number of newest MINOR lines supported per major.
+	MinorsPerMajor int
+
+	// LatestPatchOnly restricts support to the newest patch of each line.
+	LatestPatchOnly bool
+}
+
+// Supported reports whether v falls inside the policy window computed from
+// available. Invalid versions are never supported. A prerelease is supported
+// when its MAJOR.MINOR line is, unless LatestPatchOnly is set.
+func (p SupportPolicy) Supported(v Semver, available List) bool {
+	if !v.Valid {
+		return false
+	}
+
+	return p.supported(v, p.lines(available))
+}
+
+// EOLCandidates returns the valid versions of available that fall outside
+// the policy window, in input order.
+func (p SupportPolicy) EOLCandidates(available List) List {
+	lines := p.lines(available)
+
+	var out List
+	for _, v := range available {
+		if v.Valid && !p.supported(v, lines) {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+// supported checks v against precomputed supported lines.
+func (p SupportPolicy) supported(v Semver, lines map[[2]int]Semver) bool {
+	latest, ok := lines[[2]int{v.Major, v.Minor}]
+	if !ok {
+		return false
+	}
+	if p.LatestPatchOnly {
+		return v.IsEqual(latest)
+	}
+
+	return true
+}
+
+// lines returns the supported MAJOR.MINOR lines mapped to their newest release.
+func (p SupportPolicy) lines(available List) map[[2]int]Semver {
+	stable := make(List, 0, len(available))
+	for _, v := range available {
+		if v.Valid && !v.HasPre() {
+			stable = append(stable, v)
+		}
+	}
+
+	latest := stable.LatestPerMinor()
+
+	majors := make([]int, 0, len(latest))
+	minors := make(map[int][]int)
+	for line := range latest {
+		if _, ok := minors[line[0]]; !ok {
+			majors = append(majors, line[0])
+		}
+		minors[line[0]] = append(minors[line[0]], line[1])
+	}
+
+	slices.Sort(majors)
+	slices.Reverse(majors)
+	if p.Majors > 0 && len(majors) > p.Majors {
+		majors = majors[:p.Majors]
+	}
+
+	out := make(map[[2]int]Semver)
+	for _, major := range majors {
+		ms := minors[major]
+		slices.Sort(ms)
+		slices.Reverse(ms)
+		if p.MinorsPerMajor > 0 && len(ms) > p.MinorsPerMajor {
+			ms = ms[:p.MinorsPerMajor]
+		}
+
+		for _, minor := range ms {
+			line := [2]int{major, minor}
+			out[line] = latest[line]
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,55 @@
+package semver
+
+import "testing"
+
+func TestSupportPolicy(t *testing.T) {
+	available := mustList(
+		"1.0.0", "1.1.0", "1.2.0", "1.2.1",
+		"2.0.0", "2.1.0", "2.1.1", "2.2.0-rc.1",
+		"3.0.0", "3.1.0-beta.1",
+	)
+
+	tests := []struct {
+		p    SupportPolicy
+		v    string
+		want bool
+	}{
+		{SupportPolicy{Majors: 2}, "2.0.0", true},
+		{SupportPolicy{Majors: 2}, "1.2.1", false},
+		{SupportPolicy{Majors: 2, MinorsPerMajor: 1}, "2.0.0", false},
+		{SupportPolicy{Majors: 2, MinorsPerMajor: 1}, "2.1.0", true},
+		{SupportPolicy{Majors: 2, MinorsPerMajor: 1}, "3.0.5", true},
+
+		// prereleases do not open a line but follow their line's support
+		{SupportPolicy{MinorsPerMajor: 1}, "2.2.0-rc.1", false},
+		{SupportPolicy{MinorsPerMajor: 1}, "2.1.2-rc.1", true},
+
+		{SupportPolicy{LatestPatchOnly: true}, "1.2.0", false},
+		{SupportPolicy{LatestPatchOnly: true}, "v1.2.1+build", true},
+		{SupportPolicy{}, "0.9.0", false},
+		{SupportPolicy{}, "bad", false},
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.v)
+		if got := tt.p.Supported(v, available); got != tt.want {
+			t.Errorf("%+v.Supported(%q) = %v, want %v", tt.p, tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestSupportPolicy_EOLCandidates(t *testing.T) {
+	available := mustList("2.1.1", "1.2.0", "bad", "2.0.0", "2.1.0", "3.0.0", "1.1.0")
+	p := SupportPolicy{Majors: 2, MinorsPerMajor: 1, LatestPatchOnly: true}
+
+	got := p.EOLCandidates(available)
+	want := []string{"1.2.0", "2.0.0", "2.1.0", "1.1.0"}
+	if len(got) != len(want) {
+		t.Fatalf("EOLCandidates = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].Original != want[i] {
+			t.Fatalf("EOLCandidates = %v, want %v", got, want)
+		}
+	}
+}
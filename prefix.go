@@ -0,0 +1,52 @@
+package semver
+
+// ParsePrefixed parses a tag that carries an arbitrary prefix before the
+// version, e.g. "cmd/tool/v1.4.0" -> ("cmd/tool/", v1.4.0) or
+// "release-2.0.1" -> ("release-", 2.0.1).
+//
+// The version must start at the beginning of s or right after a
+// non-alphanumeric separator other than '.' ('/', '-', '_', '@', ...). The leftmost
+// position producing a valid version wins, so "release-2.0.1-rc.1" keeps its
+// prerelease. A tag without a prefix yields an empty prefix.
+func ParsePrefixed(s string) (prefix string, v Semver, ok bool) {
+	for i := 0; i < len(s); i++ {
+		if i > 0 && (isAlnum(s[i-1]) || s[i-1] == '.') {
+			continue
+		}
+
+		c := s[i]
+		if c == 'v' || c == 'V' {
+			if i+1 >= len(s) || s[i+1] < '0' || s[i+1] > '9' {
+				continue
+			}
+		} else if c < '0' || c > '9' {
+			continue
+		}
+
+		if v, ok := Parse(s[i:]); ok {
+			return s[:i], v, true
+		}
+	}
+
+	return "", Semver{Original: s, Valid: false}, false
+}
+
+// ParseListPrefixed parses tags with ParsePrefixed and returns the versions
+// whose prefix equals prefix, in input order. Tags with another prefix or
+// without a valid version are skipped.
+func ParseListPrefixed(tags []string, prefix string) List {
+	ls := make(List, 0, len(tags))
+	for _, tag := range tags {
+		p, v, ok := ParsePrefixed(tag)
+		if ok && p == prefix {
+			ls = append(ls, v)
+		}
+	}
+
+	return ls
+}
+
+// isAlnum reports whether c is an ASCII letter or digit.
+func isAlnum(c byte) bool {
+	return 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9'
+}
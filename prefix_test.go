@@ -0,0 +1,42 @@
+package semver
+
+import "testing"
+
+// TestParsePrefixed checks prefix splitting for monorepo and release tags.
+func TestParsePrefixed(t *testing.T) {
+	tests := []struct {
+		in, prefix, canon string
+		ok                bool
+	}{
+		{"v1.2.3", "", "v1.2.3", true},
+		{"cmd/tool/v1.4.0", "cmd/tool/", "v1.4.0", true},
+		{"release-2.0.1", "release-", "v2.0.1", true},
+		{"release-2.0.1-rc.1", "release-", "v2.0.1-rc.1", true},
+		{"v1-app-2.0.0", "v1-app-", "v2.0.0", true},
+		{"app@V3", "app@", "v3.0.0", true},
+		{"foo1.2.3", "", "", false},
+		{"app/latest", "", "", false},
+	}
+
+	for _, tt := range tests {
+		p, v, ok := ParsePrefixed(tt.in)
+		if ok != tt.ok || p != tt.prefix || v.Canonical() != tt.canon {
+			t.Errorf("ParsePrefixed(%q) = (%q, %q, %v), want (%q, %q, %v)",
+				tt.in, p, v.Canonical(), ok, tt.prefix, tt.canon, tt.ok)
+		}
+	}
+}
+
+// TestParseListPrefixed checks filtering of tags by prefix.
+func TestParseListPrefixed(t *testing.T) {
+	tags := []string{"api/v1.0.0", "web/v2.0.0", "api/v1.1.0", "api/next", "v9.0.0"}
+
+	ls := ParseListPrefixed(tags, "api/")
+	if len(ls) != 2 || ls[0].Original != "v1.0.0" || ls[1].Original != "v1.1.0" {
+		t.Fatalf("ParseListPrefixed(api/) = %v", ls)
+	}
+
+	if ls := ParseListPrefixed(tags, ""); len(ls) != 1 || ls[0].Original != "v9.0.0" {
+		t.Fatalf("ParseListPrefixed(\"\") = %v", ls)
+	}
+}
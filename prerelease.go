@@ -1,5 +1,49 @@
 package semver
 
+import "strconv"
+
+// PreIdent is a classified prerelease identifier.
+type PreIdent struct {
+	// Value is the identifier text, e.g. "rc" or "11".
+	Value string
+
+	// Num is the numeric value when Numeric is set and it fits into int.
+	Num int
+
+	// Numeric reports an identifier of digits only; numeric identifiers
+	// compare by value and sort before alphanumeric ones.
+	Numeric bool
+}
+
+// PrereleaseIdentifiers returns the classified dot separated prerelease
+// identifiers, e.g. [{rc 0 false} {11 11 true}] for "-rc.11".
+// Returns nil when there is no prerelease.
+func (v Semver) PrereleaseIdentifiers() []PreIdent {
+	if v.Prerelease == "" {
+		return nil
+	}
+
+	out := make([]PreIdent, 0, 4)
+	for rest := v.Prerelease; rest != ""; {
+		var id string
+		id, rest = nextIdent(rest)
+
+		pi := PreIdent{Value: id, Numeric: id != "" && isNum(id)}
+		if n, err := strconv.Atoi(id); pi.Numeric && err == nil {
+			pi.Num = n
+		}
+		out = append(out, pi)
+	}
+
+	return out
+}
+
+// Compare compares two identifiers by SemVer prerelease precedence.
+// Returns -1, 0 or +1.
+func (a PreIdent) Compare(b PreIdent) int {
+	return comparePrerelease(a.Value, b.Value)
+}
+
 // comparePrerelease compares two prerelease strings (without the leading '-').
 // Rules:
 //   - Empty string (no prerelease) has higher precedence than any prerelease.
@@ -20,15 +64,11 @@ func comparePrerelease(a, b string) int {
 		return -1
 	}
 
-	// Work with "-a" and "-b" to reuse the original state machine.
-	x := "-" + a
-	y := "-" + b
-	for x != "" && y != "" {
-		x = x[1:] // skip - or .
-		y = y[1:]
+	// Walk both strings identifier by identifier without allocating.
+	for a != "" && b != "" {
 		var dx, dy string
-		dx, x = nextIdent(x)
-		dy, y = nextIdent(y)
+		dx, a = nextIdent(a)
+		dy, b = nextIdent(b)
 		if dx != dy {
 			ix := isNum(dx)
 			iy := isNum(dy)
@@ -57,14 +97,16 @@ func comparePrerelease(a, b string) int {
 		}
 	}
 
-	if x == "" {
+	// all shared identifiers are equal: the shorter set has lower precedence
+	if a == "" {
 		return -1
 	}
 
 	return +1
 }
 
-// nextIdent returns the next identifier in x (up to '.'), and the rest.
+// nextIdent returns the next identifier in x (up to '.'), and the rest
+// after the separating '.'.
 func nextIdent(x string) (dx, rest string) {
 	i := 0
 	for i < len(x) && x[i] != '.' {
@@ -75,7 +117,7 @@ func nextIdent(x string) (dx, rest string) {
 		return x, ""
 	}
 
-	return x[:i], x[i:]
+	return x[:i], x[i+1:]
 }
 
 // isNum reports whether v consists entirely of digits.
@@ -0,0 +1,59 @@
+package semver
+
+import "testing"
+
+// TestComparePrerelease covers precedence rules and zero allocations.
+func TestComparePrerelease(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"alpha", "alpha", 0},
+		{"", "alpha", 1},
+		{"alpha", "alpha.1", -1},
+		{"alpha.1", "alpha.beta", -1},
+		{"beta.2", "beta.11", -1},
+		{"beta.11", "rc.1", -1},
+		{"1", "a", -1},
+		{"a.b.c", "a.b", 1},
+		{"a-1", "a.1", 1},
+	}
+
+	for _, tt := range tests {
+		if got := comparePrerelease(tt.a, tt.b); got != tt.want {
+			t.Errorf("comparePrerelease(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := comparePrerelease(tt.b, tt.a); got != -tt.want {
+			t.Errorf("comparePrerelease(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+		if n := testing.AllocsPerRun(10, func() { sinkInt = comparePrerelease(tt.a, tt.b) }); n != 0 {
+			t.Errorf("comparePrerelease(%q, %q) allocates %v times, want 0", tt.a, tt.b, n)
+		}
+	}
+}
+
+func TestPrereleaseIdentifiers(t *testing.T) {
+	got := MustParse("1.0.0-rc.11.x-1.99999999999999999999").PrereleaseIdentifiers()
+	want := []PreIdent{
+		{Value: "rc"},
+		{Value: "11", Num: 11, Numeric: true},
+		{Value: "x-1"},
+		{Value: "99999999999999999999", Numeric: true},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("PrereleaseIdentifiers = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ident %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	if got[1].Compare(got[3]) != -1 || got[3].Compare(got[0]) != -1 || got[0].Compare(got[0]) != 0 {
+		t.Errorf("PreIdent.Compare mismatch")
+	}
+	if MustParse("1.0.0+b").PrereleaseIdentifiers() != nil {
+		t.Errorf("PrereleaseIdentifiers without prerelease must be nil")
+	}
+}
@@ -1,6 +1,9 @@
 package semver
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+)
 
 type PrintFlags uint16
 
@@ -17,6 +20,15 @@ const (
 	PrintPrerelease
 	PrintBuild
 
+	// include the fourth numeric component (ParseOptions.AllowRevision)
+	PrintRevision
+
+	// include the "EPOCH:" prefix (ParseOptions.AllowEpoch)
+	PrintEpoch
+
+	// render components left out of MAJOR.MINOR.PATCH as 'x' ("v1.2.x")
+	PrintWildcard
+
 	// MAJOR.MINOR.PATCH
 	PrintMaskRelease = PrintMajor | PrintMinor | PrintPatch
 
@@ -27,107 +39,177 @@ const (
 	PrintMaskSemVer = PrintPrefixNoV | PrintMaskRelease | PrintPrerelease | PrintBuild
 
 	// Preserve original prefix style and print everything available.
-	PrintMaskDefault = PrintMaskRelease | PrintPrerelease | PrintBuild
+	PrintMaskDefault = PrintEpoch | PrintMaskRelease | PrintRevision | PrintPrerelease | PrintBuild
+
+	// vMAJOR, coarsened for logs and telemetry (see Redact)
+	PrintMaskMajorOnly = PrintPrefixV | PrintMajor
+
+	// vMAJOR.MINOR
+	PrintMaskMinorOnly = PrintPrefixV | PrintMajor | PrintMinor
+
+	// vMAJOR.x.x
+	PrintMaskMajorX = PrintMaskMajorOnly | PrintWildcard
+
+	// vMAJOR.MINOR.x
+	PrintMaskMinorX = PrintMaskMinorOnly | PrintWildcard
 )
 
-// Print renders according to mask. It never invents prerelease/build, but
-// zero-fills absent MINOR/PATCH to keep semver shape if they are requested.
-func (v *Semver) Print(mask PrintFlags) string {
-	if !v.Valid {
-		return ""
-	}
+// printPlan describes what Print/AppendPrint render for a given mask.
+type printPlan struct {
+	// numeric components (zero-filled if absent in input)
+	epo, maj, min, pat, rev int
+
+	// exact rendered length in bytes
+	total int
+
+	// prefix byte, 0 for none
+	pfx byte
+
+	// number of ".x" wildcards after the printed core
+	wild int
+
+	// requested parts
+	epoch, major, minor, patch, revision, pre, build bool
+}
+
+// plan resolves mask against v into a printPlan.
+func (v *Semver) plan(mask PrintFlags) printPlan {
+	var p printPlan
 
 	// decide prefix
-	var pfx byte
 	switch {
 	case (mask & PrintPrefixV) != 0:
-		pfx = 'v'
+		p.pfx = 'v'
 	case (mask & PrintPrefixNoV) != 0:
-		pfx = 0
+		p.pfx = 0
 	default:
-		if v.HasV() && len(v.Original) > 0 {
-			pfx = v.Original[0] // preserve exact 'v' or 'V'
+		if v.HasV() {
+			p.pfx = v.prefixByte() // preserve exact 'v' or 'V'
 		}
 	}
 
+	p.epoch = (mask&PrintEpoch) != 0 && (v.Flags&FlagHasEpoch) != 0
+	p.epo = v.Epoch
+
 	// determine which release parts are requested
-	reqMajor := (mask & PrintMajor) != 0
-	reqMinor := (mask & PrintMinor) != 0
-	reqPatch := (mask & PrintPatch) != 0
+	p.major = (mask & PrintMajor) != 0
+	p.minor = (mask & PrintMinor) != 0
+	p.patch = (mask & PrintPatch) != 0
 
 	// zero-filled values if absent in input
-	maj := v.Major // major is always parsed for valid semver
-	min := v.Minor
-	pat := v.Patch
-	if reqMinor && (v.Flags&FlagHasMinor) == 0 {
-		min = 0
+	p.maj = v.Major // major is always parsed for valid semver
+	p.min = v.Minor
+	p.pat = v.Patch
+	if p.minor && (v.Flags&FlagHasMinor) == 0 {
+		p.min = 0
 	}
-	if reqPatch && (v.Flags&FlagHasPatch) == 0 {
-		pat = 0
+	if p.patch && (v.Flags&FlagHasPatch) == 0 {
+		p.pat = 0
 	}
 
 	// semver shape guard: if PATCH is requested but MINOR is not,
 	// we must still print MINOR (zero-filled) to keep MAJOR.MINOR.PATCH.
-	if reqPatch && !reqMinor {
-		reqMinor = true
+	if p.patch && !p.minor {
+		p.minor = true
 		if (v.Flags & FlagHasMinor) == 0 {
-			min = 0
+			p.min = 0
 		}
 	}
 	// similarly, if MINOR is requested but MAJOR is not (weird), still print MAJOR to keep shape.
-	if reqMinor && !reqMajor {
-		reqMajor = true
+	if p.minor && !p.major {
+		p.major = true
 	}
 
+	// wildcards for the core components left out
+	if (mask&PrintWildcard) != 0 && p.major && !p.patch {
+		p.wild = 1
+		if !p.minor {
+			p.wild = 2
+		}
+	}
+
+	// revision only after a printed patch
+	p.revision = p.patch && (mask&PrintRevision) != 0 && (v.Flags&FlagHasRevision) != 0
+	p.rev = v.Revision
+
 	// prerelease/build presence
-	withPre := (mask&PrintPrerelease) != 0 && (v.Flags&FlagHasPre) != 0 && v.Prerelease != ""
-	withBuild := (mask&PrintBuild) != 0 && (v.Flags&FlagHasBuild) != 0 && v.Build != ""
+	p.pre = (mask&PrintPrerelease) != 0 && (v.Flags&FlagHasPre) != 0 && v.Prerelease != ""
+	p.build = (mask&PrintBuild) != 0 && (v.Flags&FlagHasBuild) != 0 && v.Build != ""
 
 	// pre-calc length
-	total := 0
-	if pfx != 0 {
-		total++
+	if p.epoch {
+		p.total += digits10(p.epo) + 1 // EPOCH ':'
+	}
+	if p.pfx != 0 {
+		p.total++
 	}
-	if reqMajor {
-		total += digits10(maj)
+	if p.major {
+		p.total += digits10(p.maj)
 	}
-	if reqMinor {
-		total += 1 + digits10(min)
+	if p.minor {
+		p.total += 1 + digits10(p.min)
 	}
-	if reqPatch {
-		total += 1 + digits10(pat)
+	if p.patch {
+		p.total += 1 + digits10(p.pat)
 	}
-	if withPre {
-		total += 1 + len(v.Prerelease) // '-' + pre
+	p.total += 2 * p.wild // ".x"
+	if p.revision {
+		p.total += 1 + digits10(p.rev)
 	}
-	if withBuild {
-		total += 1 + len(v.Build) // '+' + build
+	if p.pre {
+		p.total += 1 + len(v.Prerelease) // '-' + pre
+	}
+	if p.build {
+		p.total += 1 + len(v.Build) // '+' + build
+	}
+
+	return p
+}
+
+// Print renders according to mask. It never invents prerelease/build, but
+// zero-fills absent MINOR/PATCH to keep semver shape if they are requested.
+func (v *Semver) Print(mask PrintFlags) string {
+	if !v.Valid {
+		return ""
 	}
-	if total == 0 {
+
+	p := v.plan(mask)
+	if p.total == 0 {
 		return ""
 	}
 
 	var b strings.Builder
-	b.Grow(total)
-	if pfx != 0 {
-		b.WriteByte(pfx)
+	b.Grow(p.total)
+	if p.epoch {
+		writeInt(&b, p.epo)
+		b.WriteByte(':')
 	}
-	if reqMajor {
-		writeInt(&b, maj)
+	if p.pfx != 0 {
+		b.WriteByte(p.pfx)
 	}
-	if reqMinor {
+	if p.major {
+		writeInt(&b, p.maj)
+	}
+	if p.minor {
 		b.WriteByte('.')
-		writeInt(&b, min)
+		writeInt(&b, p.min)
 	}
-	if reqPatch {
+	if p.patch {
 		b.WriteByte('.')
-		writeInt(&b, pat)
+		writeInt(&b, p.pat)
+	}
+	for i := 0; i < p.wild; i++ {
+		b.WriteString(".x")
 	}
-	if withPre {
+	if p.revision {
+		b.WriteByte('.')
+		writeInt(&b, p.rev)
+	}
+	if p.pre {
 		b.WriteByte('-')
 		b.WriteString(v.Prerelease)
 	}
-	if withBuild {
+	if p.build {
 		b.WriteByte('+')
 		b.WriteString(v.Build)
 	}
@@ -135,8 +217,69 @@ func (v *Semver) Print(mask PrintFlags) string {
 	return b.String()
 }
 
+// AppendPrint appends the rendering of Print(mask) to dst and returns the
+// extended buffer. It does not allocate when dst has enough capacity.
+// Invalid versions append nothing.
+func (v *Semver) AppendPrint(dst []byte, mask PrintFlags) []byte {
+	if !v.Valid {
+		return dst
+	}
+
+	p := v.plan(mask)
+	if p.epoch {
+		dst = strconv.AppendInt(dst, int64(p.epo), 10)
+		dst = append(dst, ':')
+	}
+	if p.pfx != 0 {
+		dst = append(dst, p.pfx)
+	}
+	if p.major {
+		dst = strconv.AppendInt(dst, int64(p.maj), 10)
+	}
+	if p.minor {
+		dst = append(dst, '.')
+		dst = strconv.AppendInt(dst, int64(p.min), 10)
+	}
+	if p.patch {
+		dst = append(dst, '.')
+		dst = strconv.AppendInt(dst, int64(p.pat), 10)
+	}
+	for i := 0; i < p.wild; i++ {
+		dst = append(dst, ".x"...)
+	}
+	if p.revision {
+		dst = append(dst, '.')
+		dst = strconv.AppendInt(dst, int64(p.rev), 10)
+	}
+	if p.pre {
+		dst = append(dst, '-')
+		dst = append(dst, v.Prerelease...)
+	}
+	if p.build {
+		dst = append(dst, '+')
+		dst = append(dst, v.Build...)
+	}
+
+	return dst
+}
+
+// AppendCanonical appends Canonical() to dst and returns the extended buffer.
+func (v *Semver) AppendCanonical(dst []byte) []byte {
+	return v.AppendPrint(dst, PrintMaskCanonical)
+}
+
+// AppendFull appends Full(preserve) to dst and returns the extended buffer.
+func (v *Semver) AppendFull(dst []byte, preserve bool) []byte {
+	return v.AppendPrint(dst, fullMask(preserve))
+}
+
 // Canonical returns "vMAJOR.MINOR.PATCH[-PRERELEASE]".
 // Build metadata is intentionally stripped.
+//
+// The Epoch and Revision of ParseWith versions are left out as well, so the
+// canonical form is not a precedence identity for them: "1.2.3.4" and
+// "1.2.3.5" share "v1.2.3" but compare unequal. Dedupe such versions by Key,
+// or compare with CompareWith and IgnoreRevision.
 func (v *Semver) Canonical() string {
 	return v.Print(PrintMaskCanonical)
 }
@@ -161,13 +304,18 @@ func (v *Semver) SemVer() string {
 //
 // If preserve is false, it always forces a lowercase 'v' prefix.
 func (v *Semver) Full(preserve bool) string {
+	return v.Print(fullMask(preserve))
+}
+
+// fullMask returns the print mask used by Full and AppendFull.
+func fullMask(preserve bool) PrintFlags {
 	mask := PrintMaskDefault
 	if preserve {
 		mask |= PrintPrefixV
 	}
 
 	// else preserve by leaving both prefix flags unset
-	return v.Print(mask)
+	return mask
 }
 
 // MajorStr returns "vMAJOR". Empty if invalid.
@@ -190,6 +338,60 @@ func (v Semver) ReleaseStr() string {
 	return (&v).Print(PrintPrefixV | PrintMaskRelease)
 }
 
+// CanonicalOptions tunes CanonicalWith.
+type CanonicalOptions struct {
+	// Width zero-pads MAJOR, MINOR and PATCH to at least Width digits
+	// ("001.002.003" for 3), e.g. for lexicographically sortable file
+	// names. Prerelease identifiers are not padded. <= 1 means no padding.
+	Width int
+
+	// OmitV drops the leading 'v'.
+	OmitV bool
+
+	// KeepBuild keeps build metadata.
+	KeepBuild bool
+}
+
+// CanonicalWith renders the canonical form "vMAJOR.MINOR.PATCH[-PRERELEASE]"
+// adjusted by opts. CanonicalWith(CanonicalOptions{}) equals Canonical().
+// Empty if invalid.
+func (v *Semver) CanonicalWith(opts CanonicalOptions) string {
+	if !v.Valid {
+		return ""
+	}
+
+	var b strings.Builder
+	b.Grow(3*max(opts.Width, 1) + 4 + len(v.Prerelease) + len(v.Build) + 2)
+	if !opts.OmitV {
+		b.WriteByte('v')
+	}
+
+	writePadded(&b, v.Major, opts.Width)
+	b.WriteByte('.')
+	writePadded(&b, v.Minor, opts.Width)
+	b.WriteByte('.')
+	writePadded(&b, v.Patch, opts.Width)
+
+	if v.Flags&FlagHasPre != 0 && v.Prerelease != "" {
+		b.WriteByte('-')
+		b.WriteString(v.Prerelease)
+	}
+	if opts.KeepBuild && v.Flags&FlagHasBuild != 0 && v.Build != "" {
+		b.WriteByte('+')
+		b.WriteString(v.Build)
+	}
+
+	return b.String()
+}
+
+// writePadded writes x zero-padded to at least width digits.
+func writePadded(b *strings.Builder, x, width int) {
+	for i := digits10(x); i < width; i++ {
+		b.WriteByte('0')
+	}
+	writeInt(b, x)
+}
+
 // writeInt writes a non-negative integer to the builder using a small stack buffer.
 func writeInt(b *strings.Builder, x int) {
 	// handle zero fast-path
@@ -307,3 +307,70 @@ func TestReleaseStr(t *testing.T) {
 		}
 	}
 }
+
+// TestAppendPrint ensures Append* APIs match their string counterparts and do not allocate.
+func TestAppendPrint(t *testing.T) {
+	masks := []PrintFlags{
+		PrintMaskCanonical, PrintMaskSemVer, PrintMaskDefault, PrintMaskRelease,
+		PrintPrefixV | PrintMajor, PrintPrefixV | PrintMajor | PrintMinor,
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.in)
+		for _, m := range masks {
+			if got, want := string(v.AppendPrint([]byte("x:"), m)), "x:"+v.Print(m); got != want {
+				t.Errorf("AppendPrint(%q, %b) = %q, want %q", tt.in, m, got, want)
+			}
+		}
+		if got := string(v.AppendCanonical(nil)); got != v.Canonical() {
+			t.Errorf("AppendCanonical(%q) = %q, want %q", tt.in, got, v.Canonical())
+		}
+		for _, preserve := range []bool{true, false} {
+			if got := string(v.AppendFull(nil, preserve)); got != v.Full(preserve) {
+				t.Errorf("AppendFull(%q, %v) = %q, want %q", tt.in, preserve, got, v.Full(preserve))
+			}
+		}
+	}
+
+	v, _ := Parse("v1.2.3-rc.1+build.5")
+	buf := make([]byte, 0, 64)
+	if n := testing.AllocsPerRun(100, func() { buf = v.AppendFull(buf[:0], false) }); n != 0 {
+		t.Errorf("AppendFull allocates %v times, want 0", n)
+	}
+}
+
+// BenchmarkAppendCanonical benchmarks rendering into a reused buffer.
+func BenchmarkAppendCanonical(b *testing.B) {
+	v, _ := Parse("v1.2.3-rc.1+build.5")
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = v.AppendCanonical(buf[:0])
+	}
+	sinkStr = string(buf)
+}
+
+func TestCanonicalWith(t *testing.T) {
+	tests := []struct {
+		in   string
+		opts CanonicalOptions
+		want string
+	}{
+		{"V1.2.3-rc.1+b", CanonicalOptions{}, "v1.2.3-rc.1"},
+		{"1.2.3-rc.1+b", CanonicalOptions{OmitV: true, KeepBuild: true}, "1.2.3-rc.1+b"},
+		{"1.2", CanonicalOptions{Width: 3, OmitV: true}, "001.002.000"},
+		{"v10.200.3000+b", CanonicalOptions{Width: 3, KeepBuild: true}, "v010.200.3000+b"},
+		{"1.2.3-rc.1", CanonicalOptions{Width: 2}, "v01.02.03-rc.1"},
+		{"bad", CanonicalOptions{}, ""},
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.in)
+		if got := v.CanonicalWith(tt.opts); got != tt.want {
+			t.Errorf("%q.CanonicalWith(%+v) = %q, want %q", tt.in, tt.opts, got, tt.want)
+		}
+		if tt.opts == (CanonicalOptions{}) && v.CanonicalWith(tt.opts) != v.Canonical() {
+			t.Errorf("%q: CanonicalWith(zero) != Canonical()", tt.in)
+		}
+	}
+}
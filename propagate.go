@@ -0,0 +1,117 @@
+package semver
+
+import (
+	"fmt"
+	"slices"
+)
+
+// PlannedBump is the bump PropagateBumps plans for one module.
+type PlannedBump struct {
+	// Causes are the dependencies whose changes forced the bump, sorted.
+	Causes []string
+
+	// Level is the required bump.
+	Level DiffLevel
+
+	// Direct reports that the module itself changed.
+	Direct bool
+}
+
+// BumpPlan maps module names to their planned bumps, see PropagateBumps.
+type BumpPlan map[string]PlannedBump
+
+// PropagateBumps computes the bumps a release needs when the modules in
+// changed changed at the given levels. graph maps each module to the
+// modules it depends on.
+//
+// A dependent needs at least the level of each changed dependency: a patch
+// change of a dependency forces a patch bump, a minor change a minor bump,
+// and so on, transitively. Prerelease and build changes are planned for the
+// module itself but do not propagate. Cycles are allowed. Modules needing
+// no bump are absent from the plan.
+func PropagateBumps(graph map[string][]string, changed map[string]DiffLevel) BumpPlan {
+	dependents := make(map[string][]string)
+	for name, deps := range graph {
+		for _, dep := range deps {
+			dependents[dep] = append(dependents[dep], name)
+		}
+	}
+
+	level := make(map[string]DiffLevel, len(changed))
+	queue := make([]string, 0, len(changed))
+	for name, l := range changed {
+		if l != DiffNone {
+			level[name] = l
+			queue = append(queue, name)
+		}
+	}
+
+	// levels only grow and are bounded, so the worklist terminates
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		l := level[name]
+		if l < DiffPatch {
+			continue
+		}
+		for _, d := range dependents[name] {
+			if l > level[d] {
+				level[d] = l
+				queue = append(queue, d)
+			}
+		}
+	}
+
+	plan := make(BumpPlan, len(level))
+	for name, l := range level {
+		p := PlannedBump{Level: l}
+		_, p.Direct = changed[name]
+		for _, dep := range graph[name] {
+			if level[dep] >= DiffPatch && !slices.Contains(p.Causes, dep) {
+				p.Causes = append(p.Causes, dep)
+			}
+		}
+		slices.Sort(p.Causes)
+		plan[name] = p
+	}
+
+	return plan
+}
+
+// Apply returns a new map with the bumps of plan applied: DiffMajor,
+// DiffMinor and DiffPatch bump that component, DiffPrerelease advances the
+// prerelease (see Semver.Bump; a release moves to the first prerelease of
+// its next patch) and DiffBuild leaves the version as is. Planned modules
+// missing from m are skipped. It fails with an error wrapping
+// ErrInvalidVersion naming the first module (in name order) that can not
+// be bumped.
+func (m VersionMap) Apply(plan BumpPlan) (VersionMap, error) {
+	out := make(VersionMap, len(m))
+	for _, name := range m.Names() {
+		v := m[name]
+		p, ok := plan[name]
+		if !ok || p.Level < DiffPrerelease {
+			out[name] = v
+			continue
+		}
+
+		kind := BumpKindPrerelease
+		switch p.Level {
+		case DiffPatch:
+			kind = BumpKindPatch
+		case DiffMinor:
+			kind = BumpKindMinor
+		case DiffMajor:
+			kind = BumpKindMajor
+		}
+
+		next, ok := v.Bump(kind)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s %q", ErrInvalidVersion, name, v.Original)
+		}
+		out[name] = next
+	}
+
+	return out, nil
+}
@@ -0,0 +1,109 @@
+package semver
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestPropagateBumps(t *testing.T) {
+	// app -> api -> core, cli -> core, docs -> (nothing), a <-> b
+	graph := map[string][]string{
+		"app": {"api", "core"},
+		"api": {"core"},
+		"cli": {"core"},
+		"a":   {"b"},
+		"b":   {"a"},
+	}
+
+	tests := []struct {
+		name    string
+		changed map[string]DiffLevel
+		want    map[string]DiffLevel
+	}{
+		{"none", map[string]DiffLevel{"core": DiffNone}, map[string]DiffLevel{}},
+		{
+			"patch",
+			map[string]DiffLevel{"core": DiffPatch},
+			map[string]DiffLevel{"core": DiffPatch, "api": DiffPatch, "cli": DiffPatch, "app": DiffPatch},
+		},
+		{
+			"max of causes",
+			map[string]DiffLevel{"core": DiffPatch, "api": DiffMinor},
+			map[string]DiffLevel{"core": DiffPatch, "api": DiffMinor, "cli": DiffPatch, "app": DiffMinor},
+		},
+		{
+			"direct lower than forced",
+			map[string]DiffLevel{"core": DiffMajor, "app": DiffPatch},
+			map[string]DiffLevel{"core": DiffMajor, "api": DiffMajor, "cli": DiffMajor, "app": DiffMajor},
+		},
+		{
+			"prerelease does not propagate",
+			map[string]DiffLevel{"core": DiffPrerelease, "docs": DiffBuild},
+			map[string]DiffLevel{"core": DiffPrerelease, "docs": DiffBuild},
+		},
+		{
+			"cycle",
+			map[string]DiffLevel{"a": DiffMinor},
+			map[string]DiffLevel{"a": DiffMinor, "b": DiffMinor},
+		},
+	}
+
+	for _, tt := range tests {
+		plan := PropagateBumps(graph, tt.changed)
+		if len(plan) != len(tt.want) {
+			t.Errorf("%s: plan = %v, want %v", tt.name, plan, tt.want)
+			continue
+		}
+		for name, l := range tt.want {
+			if plan[name].Level != l {
+				t.Errorf("%s: plan[%s] = %v, want %v", tt.name, name, plan[name].Level, l)
+			}
+		}
+	}
+
+	plan := PropagateBumps(graph, map[string]DiffLevel{"core": DiffPatch, "api": DiffMinor})
+	if p := plan["app"]; p.Direct || !slices.Equal(p.Causes, []string{"api", "core"}) {
+		t.Errorf("plan[app] = %+v", p)
+	}
+	if p := plan["api"]; !p.Direct || !slices.Equal(p.Causes, []string{"core"}) {
+		t.Errorf("plan[api] = %+v", p)
+	}
+	if p := plan["core"]; !p.Direct || len(p.Causes) != 0 {
+		t.Errorf("plan[core] = %+v", p)
+	}
+}
+
+func TestVersionMap_Apply(t *testing.T) {
+	m := VersionMap{
+		"app":  MustParse("v1.4.2"),
+		"core": MustParse("v2.0.0-rc.1"),
+		"docs": MustParse("v0.3.0"),
+		"cli":  MustParse("v0.9.1"),
+	}
+	plan := BumpPlan{
+		"app":     {Level: DiffMinor},
+		"core":    {Level: DiffPrerelease},
+		"docs":    {Level: DiffBuild},
+		"missing": {Level: DiffMajor},
+	}
+
+	out, err := m.Apply(plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{"app": "v1.5.0", "core": "v2.0.0-rc.2", "docs": "v0.3.0", "cli": "v0.9.1"} {
+		v := out[name]
+		if got := v.Canonical(); got != want {
+			t.Errorf("Apply[%s] = %s, want %s", name, got, want)
+		}
+	}
+	if _, ok := out["missing"]; ok {
+		t.Error("Apply added a module")
+	}
+
+	m["app"] = Semver{Original: "bogus"}
+	if _, err := m.Apply(plan); !errors.Is(err, ErrInvalidVersion) {
+		t.Errorf("Apply = %v, want ErrInvalidVersion", err)
+	}
+}
@@ -0,0 +1,122 @@
+package semver
+
+import (
+	"strings"
+	"time"
+)
+
+// pseudoTimeLayout is the timestamp layout of Go pseudo-versions.
+const pseudoTimeLayout = "20060102150405"
+
+// Pseudo is a parsed Go module pseudo-version.
+//
+// Go uses three forms, all with an optional "+incompatible" suffix:
+//
+//	vX.0.0-yyyymmddhhmmss-abcdef123456        no earlier tag
+//	vX.Y.Z-pre.0.yyyymmddhhmmss-abcdef123456  based on vX.Y.Z-pre
+//	vX.Y.Z-0.yyyymmddhhmmss-abcdef123456      based on vX.Y.(Z-1)
+type Pseudo struct {
+	// Time commit timestamp (UTC)
+	Time time.Time
+
+	// Revision commit hash prefix (usually 12 hex digits)
+	Revision string
+
+	// Version the full pseudo-version as parsed
+	Version Semver
+
+	// Base the tagged version the pseudo-version builds on.
+	// Invalid (zero) for the "no earlier tag" form.
+	Base Semver
+}
+
+// IsPseudoVersion reports whether s is a Go module pseudo-version.
+func IsPseudoVersion(s string) bool {
+	_, ok := ParsePseudo(s)
+	return ok
+}
+
+// ParsePseudo parses a Go module pseudo-version and exposes its base
+// version, commit timestamp and revision. The leading 'v' is optional as
+// everywhere else in this package. Returns (zero, false) if s is not a
+// pseudo-version.
+func ParsePseudo(s string) (Pseudo, bool) {
+	v, ok := Parse(s)
+	if !ok || !v.HasPre() || v.HasBuild() && !v.IsIncompatible() {
+		return Pseudo{}, false
+	}
+
+	// last identifier: "yyyymmddhhmmss-revision"
+	pre := v.Prerelease
+	head, last := "", pre
+	if i := strings.LastIndexByte(pre, '.'); i >= 0 {
+		head, last = pre[:i], pre[i+1:]
+	}
+
+	if len(last) < len(pseudoTimeLayout)+2 || last[len(pseudoTimeLayout)] != '-' {
+		return Pseudo{}, false
+	}
+
+	stamp, rev := last[:len(pseudoTimeLayout)], last[len(pseudoTimeLayout)+1:]
+	if !isNum(stamp) || !isAlnumString(rev) {
+		return Pseudo{}, false
+	}
+
+	ts, err := time.Parse(pseudoTimeLayout, stamp)
+	if err != nil {
+		return Pseudo{}, false
+	}
+
+	p := Pseudo{Version: v, Time: ts, Revision: rev}
+
+	switch {
+	case head == "":
+		// vX.0.0-yyyymmddhhmmss-rev
+		if v.Minor != 0 || v.Patch != 0 {
+			return Pseudo{}, false
+		}
+
+	case head == "0":
+		// vX.Y.(Z+1)-0.yyyymmddhhmmss-rev
+		if v.Patch == 0 {
+			return Pseudo{}, false
+		}
+		p.Base = Semver{
+			Major: v.Major,
+			Minor: v.Minor,
+			Patch: v.Patch - 1,
+			Flags: FlagHasV | FlagHasMajor | FlagHasMinor | FlagHasPatch,
+			Valid: true,
+		}
+		p.Base.Original = p.Base.Print(PrintMaskCanonical)
+
+	case strings.HasSuffix(head, ".0"):
+		// vX.Y.Z-pre.0.yyyymmddhhmmss-rev
+		base, ok := v.WithPre(strings.TrimSuffix(head, ".0"))
+		if !ok {
+			return Pseudo{}, false
+		}
+		base, _ = base.StripBuild()
+		p.Base = base
+
+	default:
+		return Pseudo{}, false
+	}
+
+	return p, true
+}
+
+// isAlnumString reports whether s is a non-empty ASCII alphanumeric string.
+func isAlnumString(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if !isAlnum(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,49 @@
+package semver
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParsePseudo covers the three Go pseudo-version forms.
+func TestParsePseudo(t *testing.T) {
+	tests := []struct {
+		in, base, rev string
+		ok            bool
+	}{
+		{"v0.0.0-20230101000000-abcdef123456", "", "abcdef123456", true},
+		{"v2.0.0-20230101000000-abcdef123456+incompatible", "", "abcdef123456", true},
+		{"v1.2.4-0.20230101000000-abcdef123456", "v1.2.3", "abcdef123456", true},
+		{"v1.2.3-rc.1.0.20230101000000-abcdef123456", "v1.2.3-rc.1", "abcdef123456", true},
+		{"v1.2.3-pre.0.20230101000000-abcdef123456", "v1.2.3-pre", "abcdef123456", true},
+
+		{"v1.2.3", "", "", false},
+		{"v1.2.3-rc.1", "", "", false},
+		{"v1.2.0-0.20230101000000-abcdef123456", "", "", false},
+		{"v1.2.3-20230101000000-abcdef123456", "", "", false},
+		{"v0.0.0-20231301000000-abcdef123456", "", "", false},
+		{"v0.0.0-2023010100000-abcdef123456", "", "", false},
+		{"v0.0.0-20230101000000-abcdef123456+meta", "", "", false},
+	}
+
+	for _, tt := range tests {
+		p, ok := ParsePseudo(tt.in)
+		if ok != tt.ok || IsPseudoVersion(tt.in) != tt.ok {
+			t.Errorf("ParsePseudo(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		if got := p.Base.Canonical(); got != tt.base {
+			t.Errorf("ParsePseudo(%q).Base = %q, want %q", tt.in, got, tt.base)
+		}
+		if p.Revision != tt.rev {
+			t.Errorf("ParsePseudo(%q).Revision = %q, want %q", tt.in, p.Revision, tt.rev)
+		}
+		if want := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC); !p.Time.Equal(want) {
+			t.Errorf("ParsePseudo(%q).Time = %v, want %v", tt.in, p.Time, want)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package semver
+
+import "strings"
+
+// ParseTilde parses a tilde range ("~1.2.3", "~>1.2", "~1") into the
+// half-open interval [lower, upper): patch-level changes if a minor version
+// is given, minor-level changes otherwise.
+//
+//	~1.2.3  ->  [1.2.3, 1.3.0-0)
+//	~1      ->  [1.0.0, 2.0.0-0)
+//
+// The operator is optional. Wildcard-only input ("~*") is rejected.
+func ParseTilde(s string) (lower, upper Semver, ok bool) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "~>") {
+		s = s[2:]
+	} else {
+		s = strings.TrimPrefix(s, "~")
+	}
+
+	p, ok := parsePartial(strings.TrimSpace(s))
+	if !ok || p.n == 0 {
+		return Semver{}, Semver{}, false
+	}
+
+	up := p
+	if up.n == 3 {
+		up.n = 2
+	}
+
+	return p.v, upperX(up), true
+}
+
+// ParseCaret parses a caret range ("^1.2.3", "^0.2", "^0.0.3") into the
+// half-open interval [lower, upper): changes that do not modify the left-most
+// non-zero given component.
+//
+//	^1.2.3  ->  [1.2.3, 2.0.0-0)
+//	^0.2.1  ->  [0.2.1, 0.3.0-0)
+//	^0.0.3  ->  [0.0.3, 0.0.4-0)
+//
+// The operator is optional. Wildcard-only input ("^*") is rejected.
+func ParseCaret(s string) (lower, upper Semver, ok bool) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "^")
+
+	p, ok := parsePartial(strings.TrimSpace(s))
+	if !ok || p.n == 0 {
+		return Semver{}, Semver{}, false
+	}
+
+	return p.v, upperCaret(p), true
+}
+
+// ParseHyphen parses a hyphen range ("1.2 - 1.4.5") into the half-open
+// interval [lower, upper). A full upper version is inclusive and converted to
+// the lowest version above it, a partial one covers its whole x-range:
+//
+//	1.2 - 1.4.5  ->  [1.2.0, 1.4.6-0)
+//	1.2.3 - 1.4  ->  [1.2.3, 1.5.0-0)
+//
+// An upper wildcard ("1.2 - *") leaves upper invalid (unbounded).
+func ParseHyphen(s string) (lower, upper Semver, ok bool) {
+	a, b, found := strings.Cut(s, " - ")
+	if !found {
+		return Semver{}, Semver{}, false
+	}
+
+	lo, ok := parsePartial(strings.TrimSpace(a))
+	if !ok {
+		return Semver{}, Semver{}, false
+	}
+	hi, ok := parsePartial(strings.TrimSpace(b))
+	if !ok {
+		return Semver{}, Semver{}, false
+	}
+
+	switch hi.n {
+	case 0:
+		return lo.v, Semver{}, true
+	case 3:
+		return lo.v, successor(hi.v), true
+	}
+
+	return lo.v, upperX(hi), true
+}
+
+// successor returns the lowest version greater than v (build ignored):
+// "1.4.5" -> "1.4.6-0", "1.4.5-rc.1" -> "1.4.5-rc.1.0".
+func successor(v Semver) Semver {
+	if v.Flags&FlagHasPre == 0 {
+		return boundary(v.Major, v.Minor, v.Patch+1)
+	}
+
+	s := boundary(v.Major, v.Minor, v.Patch)
+	s.Prerelease = v.Prerelease + ".0"
+	s.Original = s.Print(PrintMaskDefault)
+
+	return s
+}
+
+// NextBreaking returns the lowest release that "^v" no longer admits, the
+// next version allowed to break compatibility with v under 0.x rules:
+//
+//	1.2.3  ->  2.0.0
+//	0.2.3  ->  0.3.0
+//	0.0.3  ->  0.0.4
+//
+// Prerelease and build of v are ignored, its epoch is kept ("2:1.2.3" ->
+// "2:2.0.0"). Returns (zero, false) for invalid versions.
+func (v Semver) NextBreaking() (Semver, bool) {
+	if !v.Valid {
+		return Semver{}, false
+	}
+
+	return epochOf(v, releaseOf(upperCaret(partial{v: v, n: 3}))), true
+}
+
+// NextMinorBoundary returns the lowest release that "~v" no longer admits:
+// "1.2.3" -> "1.3.0", keeping the epoch of v. Returns (zero, false) for
+// invalid versions.
+func (v Semver) NextMinorBoundary() (Semver, bool) {
+	if !v.Valid {
+		return Semver{}, false
+	}
+
+	return epochOf(v, releaseOf(upperX(partial{v: v, n: 2}))), true
+}
+
+// UpperBoundForCompatibility returns the exclusive upper bound of the
+// versions compatible with v under 0.x rules, as constraints use it: the
+// NextBreaking core with prerelease "0", so prereleases of the next
+// breaking version fall outside too.
+//
+//	1.2.3  ->  2.0.0-0    (compatible: 1.2.3 <= x < 2.0.0-0)
+//	0.2.3  ->  0.3.0-0
+//
+// The epoch of v is kept. Returns (zero, false) for invalid versions.
+func (v Semver) UpperBoundForCompatibility() (Semver, bool) {
+	if !v.Valid {
+		return Semver{}, false
+	}
+
+	return epochOf(v, upperCaret(partial{v: v, n: 3})), true
+}
+
+// releaseOf returns boundary b ("2.0.0-0") as the release of its core.
+func releaseOf(b Semver) Semver {
+	b.Prerelease = ""
+	b.Flags &^= FlagHasPre
+	b.Original = b.Print(PrintMaskDefault)
+
+	return b
+}
+
+// epochOf returns bound b carrying the epoch of v, so it still sorts above v.
+func epochOf(v, b Semver) Semver {
+	if v.Flags&FlagHasEpoch == 0 {
+		return b
+	}
+
+	b.Epoch = v.Epoch
+	b.Flags |= FlagHasEpoch
+	b.Original = b.Print(PrintMaskDefault)
+
+	return b
+}
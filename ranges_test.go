@@ -0,0 +1,133 @@
+package semver
+
+import "testing"
+
+func TestParseRangeOperators(t *testing.T) {
+	tests := []struct {
+		fn     func(string) (Semver, Semver, bool)
+		in     string
+		lo, hi string
+	}{
+		{ParseTilde, "~1.2.3", "1.2.3", "1.3.0-0"},
+		{ParseTilde, "~>1.2", "1.2.0", "1.3.0-0"},
+		{ParseTilde, "~1", "1.0.0", "2.0.0-0"},
+		{ParseTilde, "1.2.3-rc.1", "1.2.3-rc.1", "1.3.0-0"},
+		{ParseTilde, "~*", "", ""},
+		{ParseTilde, "~bad", "", ""},
+
+		{ParseCaret, "^1.2.3", "1.2.3", "2.0.0-0"},
+		{ParseCaret, "^0.2.1", "0.2.1", "0.3.0-0"},
+		{ParseCaret, "^0.0.3", "0.0.3", "0.0.4-0"},
+		{ParseCaret, "^0.0", "0.0.0", "0.1.0-0"},
+		{ParseCaret, " ^ v2 ", "2.0.0", "3.0.0-0"},
+		{ParseCaret, "^", "", ""},
+
+		{ParseHyphen, "1.2 - 1.4.5", "1.2.0", "1.4.6-0"},
+		{ParseHyphen, "1.2.3 - 1.4", "1.2.3", "1.5.0-0"},
+		{ParseHyphen, "1.0.0 - 1.4.5-rc.1", "1.0.0", "1.4.5-rc.1.0"},
+		{ParseHyphen, "1.2 - *", "1.2.0", "-"},
+		{ParseHyphen, "1.2", "", ""},
+	}
+
+	for _, tt := range tests {
+		lo, hi, ok := tt.fn(tt.in)
+		if tt.lo == "" {
+			if ok {
+				t.Errorf("%q accepted, want rejection", tt.in)
+			}
+			continue
+		}
+		if !ok {
+			t.Errorf("%q rejected", tt.in)
+			continue
+		}
+
+		if got := lo.SemVer(); got != tt.lo {
+			t.Errorf("%q lower = %q, want %q", tt.in, got, tt.lo)
+		}
+		if tt.hi == "-" {
+			if hi.Valid {
+				t.Errorf("%q upper = %q, want unbounded", tt.in, hi.Original)
+			}
+			continue
+		}
+		if got := hi.SemVer(); got != tt.hi {
+			t.Errorf("%q upper = %q, want %q", tt.in, got, tt.hi)
+		}
+	}
+}
+
+// TestParseHyphen_MatchesConstraint checks the interval agrees with ParseConstraint.
+func TestParseHyphen_MatchesConstraint(t *testing.T) {
+	for _, in := range []string{"1.2 - 1.4.5", "1.2.3 - 1.4", "1.0.0 - 1.4.5-rc.1"} {
+		lo, hi, _ := ParseHyphen(in)
+		c := MustConstraint(in)
+		for _, v := range mustList("1.1.9", "1.2.0", "1.4.5-rc.1", "1.4.5-rc.1.1", "1.4.5", "1.4.6-0", "1.4.9", "1.5.0") {
+			inside := v.Compare(lo) >= 0 && v.Compare(hi) < 0
+			if got := c.CheckWith(v, CheckOptions{IncludePrerelease: true}); got != inside {
+				t.Errorf("%q: %s in interval = %v, constraint = %v", in, v.Original, inside, got)
+			}
+		}
+	}
+}
+
+func TestBoundaries(t *testing.T) {
+	tests := []struct {
+		in                      string
+		breaking, minor, compat string
+	}{
+		{"1.2.3", "2.0.0", "1.3.0", "2.0.0-0"},
+		{"v1.2.3-rc.1+b.5", "2.0.0", "1.3.0", "2.0.0-0"},
+		{"0.2.3", "0.3.0", "0.3.0", "0.3.0-0"},
+		{"0.0.3", "0.0.4", "0.1.0", "0.0.4-0"},
+		{"0.0.0", "0.0.1", "0.1.0", "0.0.1-0"},
+	}
+
+	for _, tt := range tests {
+		v := MustParse(tt.in)
+
+		b, ok1 := v.NextBreaking()
+		m, ok2 := v.NextMinorBoundary()
+		c, ok3 := v.UpperBoundForCompatibility()
+		if !ok1 || !ok2 || !ok3 || b.SemVer() != tt.breaking || m.SemVer() != tt.minor || c.SemVer() != tt.compat {
+			t.Errorf("%s: NextBreaking = %s, NextMinorBoundary = %s, UpperBoundForCompatibility = %s, want %s %s %s",
+				tt.in, b.SemVer(), m.SemVer(), c.SemVer(), tt.breaking, tt.minor, tt.compat)
+		}
+
+		// agrees with the constraint operators
+		if !MustConstraint("^"+tt.in).Check(v) && v.Prerelease == "" {
+			t.Errorf("^%s does not admit %s", tt.in, tt.in)
+		}
+		if MustConstraint("^" + v.SemVer()).Check(b) {
+			t.Errorf("^%s admits NextBreaking %s", tt.in, b.SemVer())
+		}
+		if MustConstraint("~" + v.SemVer()).Check(m) {
+			t.Errorf("~%s admits NextMinorBoundary %s", tt.in, m.SemVer())
+		}
+	}
+
+	ev, _ := ParseWith("2:1.2.3", ParseOptions{AllowEpoch: true})
+	b, _ := ev.NextBreaking()
+	m, _ := ev.NextMinorBoundary()
+	c, _ := ev.UpperBoundForCompatibility()
+	if b.Original != "2:2.0.0" || m.Original != "2:1.3.0" || c.Original != "2:2.0.0-0" {
+		t.Errorf("epoch bounds: NextBreaking = %s, NextMinorBoundary = %s, UpperBoundForCompatibility = %s",
+			b.Original, m.Original, c.Original)
+	}
+	for _, bound := range []Semver{b, m, c} {
+		if !bound.IsGreater(ev) || bound.Epoch != 2 {
+			t.Errorf("epoch bound %s does not sort above %s", bound.Original, ev.Original)
+		}
+	}
+
+	var zero Semver
+	if _, ok := zero.NextBreaking(); ok {
+		t.Error("NextBreaking(invalid) ok")
+	}
+	if _, ok := zero.NextMinorBoundary(); ok {
+		t.Error("NextMinorBoundary(invalid) ok")
+	}
+	if _, ok := zero.UpperBoundForCompatibility(); ok {
+		t.Error("UpperBoundForCompatibility(invalid) ok")
+	}
+}
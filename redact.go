@@ -0,0 +1,26 @@
+package semver
+
+// Granularity is the precision a version is reported with by Redact and
+// MetricLabel.
+type Granularity uint8
+
+const (
+	GranularityMajor Granularity = iota // MAJOR
+	GranularityMinor                    // MAJOR.MINOR
+	GranularityPatch                    // MAJOR.MINOR.PATCH
+)
+
+// Redact renders v coarsened to g for logs and telemetry where exact
+// versions are sensitive or too high-cardinality, keeping the semver shape
+// with wildcards: "v1.x.x", "v1.2.x" or "v1.2.3". Prerelease and build are
+// always dropped. Empty if invalid.
+func (v Semver) Redact(g Granularity) string {
+	switch g {
+	case GranularityMajor:
+		return v.Print(PrintMaskMajorX)
+	case GranularityMinor:
+		return v.Print(PrintMaskMinorX)
+	}
+
+	return v.Print(PrintPrefixV | PrintMaskRelease)
+}
@@ -0,0 +1,48 @@
+package semver
+
+import "testing"
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		in                  string
+		major, minor, patch string
+	}{
+		{"v1.2.3-rc.1+secret", "v1.x.x", "v1.2.x", "v1.2.3"},
+		{"2", "v2.x.x", "v2.0.x", "v2.0.0"},
+		{"bad", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.in)
+		for g, want := range []string{tt.major, tt.minor, tt.patch} {
+			if got := v.Redact(Granularity(g)); got != want {
+				t.Errorf("Redact(%q, %d) = %q, want %q", tt.in, g, got, want)
+			}
+		}
+	}
+}
+
+func TestPrint_Coarse(t *testing.T) {
+	v := MustParse("V1.2.3-rc.1+b")
+	tests := []struct {
+		mask PrintFlags
+		want string
+	}{
+		{PrintMaskMajorOnly, "v1"},
+		{PrintMaskMinorOnly, "v1.2"},
+		{PrintMaskMajorX, "v1.x.x"},
+		{PrintMaskMinorX, "v1.2.x"},
+		{PrintMajor | PrintWildcard, "V1.x.x"},
+		{PrintMaskRelease | PrintWildcard, "V1.2.3"},
+		{PrintPrefixNoV | PrintMajor | PrintMinor | PrintWildcard | PrintPrerelease, "1.2.x-rc.1"},
+	}
+
+	for _, tt := range tests {
+		if got := v.Print(tt.mask); got != tt.want {
+			t.Errorf("Print(%b) = %q, want %q", tt.mask, got, tt.want)
+		}
+		if got := string(v.AppendPrint(nil, tt.mask)); got != tt.want {
+			t.Errorf("AppendPrint(%b) = %q, want %q", tt.mask, got, tt.want)
+		}
+	}
+}
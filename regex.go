@@ -0,0 +1,201 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// regexBuild matches optional build metadata in ToRegex output.
+const regexBuild = `(?:\+[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*)?`
+
+// regexNum matches any decimal number without leading zeros.
+const regexNum = `(?:0|[1-9]\d*)`
+
+// regexNone matches nothing.
+const regexNone = `^[^\s\S]$`
+
+// ToRegex compiles c into a regular expression (RE2/PCRE compatible)
+// matching exactly the release versions c accepts, written in full
+// "MAJOR.MINOR.PATCH" form with an optional 'v'/'V' prefix and build
+// metadata, for systems configurable only with regexes (API gateways,
+// Prometheus relabeling, registry retention rules):
+//
+//	~1.2   ->  ^[vV]?(?:1\.2\.(?:0|[1-9]\d*))(?:\+[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*)?$
+//	^1.2.3 ->  ^[vV]?(?:1\.2\.(?:[3-9]|[1-9]\d{1,})|1\.(?:[3-9]|[1-9]\d{1,})\.(?:0|[1-9]\d*))(?:\+...)?$
+//
+// Prerelease versions never match, which agrees with Check unless a bound
+// names a prerelease; such constraints are not expressible and ok is false.
+func (c Constraint) ToRegex() (string, bool) {
+	ivs := c.intervals()
+
+	var alts []string
+	for _, iv := range ivs {
+		if iv.lo.set && iv.lo.v.HasPre() ||
+			iv.hi.set && iv.hi.v.HasPre() && !(iv.hi.v.Prerelease == "0" && !iv.hi.incl) {
+			return "", false
+		}
+
+		lo := [3]int{}
+		if iv.lo.set {
+			lo = [3]int{iv.lo.v.Major, iv.lo.v.Minor, iv.lo.v.Patch}
+			if !iv.lo.incl {
+				lo[2]++
+			}
+		}
+
+		var hi *[3]int
+		if iv.hi.set {
+			h := [3]int{iv.hi.v.Major, iv.hi.v.Minor, iv.hi.v.Patch}
+			if iv.hi.incl {
+				h[2]++
+			}
+			hi = &h
+		}
+
+		alts = append(alts, tupleRegex(lo, hi)...)
+	}
+
+	if len(alts) == 0 {
+		return regexNone, true
+	}
+
+	return `^[vV]?(?:` + strings.Join(alts, "|") + `)` + regexBuild + `$`, true
+}
+
+// tupleRegex returns alternatives matching "MAJOR.MINOR.PATCH" for the
+// version cores in [lo, hi), hi nil meaning unbounded.
+func tupleRegex(lo [3]int, hi *[3]int) []string {
+	var out []string
+	add := func(maj, min, pat string) {
+		if maj != "" && min != "" && pat != "" {
+			out = append(out, maj+`\.`+min+`\.`+pat)
+		}
+	}
+	num := func(n int) string { return strconv.Itoa(n) }
+
+	if hi == nil {
+		add(num(lo[0]), num(lo[1]), atLeastRegex(lo[2]))
+		add(num(lo[0]), atLeastRegex(lo[1]+1), regexNum)
+		add(atLeastRegex(lo[0]+1), regexNum, regexNum)
+		return out
+	}
+
+	b := *hi
+	switch {
+	case lo[0] == b[0] && lo[1] == b[1]:
+		add(num(lo[0]), num(lo[1]), rangeRegex(lo[2], b[2]-1))
+	case lo[0] == b[0]:
+		add(num(lo[0]), num(lo[1]), atLeastRegex(lo[2]))
+		add(num(lo[0]), rangeRegex(lo[1]+1, b[1]-1), regexNum)
+		add(num(b[0]), num(b[1]), rangeRegex(0, b[2]-1))
+	case lo[0] < b[0]:
+		add(num(lo[0]), num(lo[1]), atLeastRegex(lo[2]))
+		add(num(lo[0]), atLeastRegex(lo[1]+1), regexNum)
+		add(rangeRegex(lo[0]+1, b[0]-1), regexNum, regexNum)
+		add(num(b[0]), rangeRegex(0, b[1]-1), regexNum)
+		add(num(b[0]), num(b[1]), rangeRegex(0, b[2]-1))
+	}
+
+	return out
+}
+
+// atLeastRegex matches decimal numbers (no leading zeros) >= a.
+func atLeastRegex(a int) string {
+	if a == 0 {
+		return regexNum
+	}
+
+	d := len(strconv.Itoa(a))
+	top := strings.Repeat("9", d)
+	upper, _ := strconv.Atoi(top)
+
+	return `(?:` + rangeRegex(a, upper) + `|[1-9]\d{` + strconv.Itoa(d) + `,})`
+}
+
+// rangeRegex matches decimal numbers (no leading zeros) in [a, b],
+// or returns "" if the range is empty.
+func rangeRegex(a, b int) string {
+	if a > b {
+		return ""
+	}
+
+	var alts []string
+	for lo := a; lo <= b; {
+		d := len(strconv.Itoa(lo))
+		end, _ := strconv.Atoi(strings.Repeat("9", d))
+		hi := min(end, b)
+		alts = append(alts, digitsRange(strconv.Itoa(lo), strconv.Itoa(hi))...)
+		lo = hi + 1
+	}
+
+	if len(alts) == 1 {
+		return alts[0]
+	}
+
+	return `(?:` + strings.Join(alts, "|") + `)`
+}
+
+// digitsRange matches numbers between lo and hi of equal length.
+func digitsRange(lo, hi string) []string {
+	n := len(lo)
+	switch {
+	case n == 0:
+		return []string{""}
+	case lo == hi:
+		return []string{lo}
+	case lo[0] == hi[0]:
+		var out []string
+		for _, rest := range digitsRange(lo[1:], hi[1:]) {
+			out = append(out, lo[:1]+rest)
+		}
+		return out
+	}
+
+	zeros, nines := strings.Repeat("0", n-1), strings.Repeat("9", n-1)
+	from, to := lo[0], hi[0]
+
+	var out []string
+	if lo[1:] != zeros {
+		for _, rest := range digitsRange(lo[1:], nines) {
+			out = append(out, lo[:1]+rest)
+		}
+		from++
+	}
+	if hi[1:] != nines {
+		to--
+	}
+	if from <= to {
+		out = append(out, digitClass(from, to)+anyDigits(n-1))
+	}
+	if hi[1:] != nines {
+		for _, rest := range digitsRange(zeros, hi[1:]) {
+			out = append(out, hi[:1]+rest)
+		}
+	}
+
+	return out
+}
+
+// digitClass matches one digit in [from, to].
+func digitClass(from, to byte) string {
+	switch {
+	case from == to:
+		return string(from)
+	case from == '0' && to == '9':
+		return `\d`
+	}
+
+	return "[" + string(from) + "-" + string(to) + "]"
+}
+
+// anyDigits matches exactly n digits.
+func anyDigits(n int) string {
+	switch n {
+	case 0:
+		return ""
+	case 1:
+		return `\d`
+	}
+
+	return `\d{` + strconv.Itoa(n) + `}`
+}
@@ -0,0 +1,83 @@
+package semver
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestRangeRegex(t *testing.T) {
+	tests := []struct {
+		a, b int
+	}{
+		{0, 0}, {0, 9}, {3, 7}, {0, 10}, {5, 123}, {17, 17}, {10, 99}, {99, 1000}, {120, 4567},
+	}
+
+	for _, tt := range tests {
+		re := regexp.MustCompile(`^` + rangeRegex(tt.a, tt.b) + `$`)
+		for n := 0; n <= 5000; n++ {
+			if got, want := re.MatchString(strconv.Itoa(n)), n >= tt.a && n <= tt.b; got != want {
+				t.Fatalf("rangeRegex(%d, %d) = %s: %d matched = %v", tt.a, tt.b, re, n, got)
+			}
+		}
+		if re.MatchString("0" + strconv.Itoa(tt.a)) {
+			t.Errorf("rangeRegex(%d, %d) matches leading zero", tt.a, tt.b)
+		}
+	}
+
+	for _, a := range []int{0, 7, 10, 95, 100} {
+		re := regexp.MustCompile(`^` + atLeastRegex(a) + `$`)
+		for n := 0; n <= 2000; n++ {
+			if got := re.MatchString(strconv.Itoa(n)); got != (n >= a) {
+				t.Fatalf("atLeastRegex(%d) = %s: %d matched = %v", a, re, n, got)
+			}
+		}
+	}
+}
+
+func TestConstraint_ToRegex(t *testing.T) {
+	constraints := []string{
+		"^1.2.3", "~3.1", "^1.2.3 || ~3.1", ">=1.9.8 <=12.0.11", ">2.10.99", "<0.3",
+		"*", ">=1.0.0 !=1.5.0 <2", "^0.0.3", "1.2 - 1.4.5", ">5 <3",
+	}
+
+	var grid []Semver
+	for maj := 0; maj <= 13; maj++ {
+		for _, min := range []int{0, 1, 2, 3, 9, 10, 11, 12} {
+			for _, pat := range []int{0, 1, 3, 5, 9, 10, 11, 12, 98, 99, 100} {
+				grid = append(grid, New(maj, min, pat))
+			}
+		}
+	}
+
+	for _, in := range constraints {
+		c := MustConstraint(in)
+		expr, ok := c.ToRegex()
+		if !ok {
+			t.Errorf("%q.ToRegex() not expressible", in)
+			continue
+		}
+		re := regexp.MustCompile(expr)
+
+		for _, v := range grid {
+			want := c.Check(v)
+			for _, s := range []string{v.Original, "v" + v.Original, v.Original + "+build.1"} {
+				if got := re.MatchString(s); got != want {
+					t.Errorf("%q.ToRegex() = %s: %s matched = %v, want %v", in, expr, s, got, want)
+				}
+			}
+			if re.MatchString(v.Original + "-rc.1") {
+				t.Errorf("%q.ToRegex() matches prerelease %s-rc.1", in, v.Original)
+			}
+			if re.MatchString(v.Original+"0") && v.Patch == 0 {
+				t.Errorf("%q.ToRegex() matches leading zero patch %s0", in, v.Original)
+			}
+		}
+	}
+
+	for _, in := range []string{">=1.2.3-rc.1", "<2.0.0-beta"} {
+		if _, ok := MustConstraint(in).ToRegex(); ok {
+			t.Errorf("%q.ToRegex() claimed expressible", in)
+		}
+	}
+}
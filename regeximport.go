@@ -0,0 +1,255 @@
+package semver
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"strings"
+)
+
+// rxToken kinds produced by regexTokens.
+const (
+	rxBegin   = 'b' // ^
+	rxEnd     = 'e' // $
+	rxLiteral = 'l' // literal rune
+	rxPrefix  = 'v' // optional 'v' prefix: v?, [vV]?
+	rxNumber  = 'n' // numeric component: \d+, [0-9]+
+	rxTail    = 't' // anything: .*, .+
+)
+
+// rxToken is one element of a flattened tag-filter regex.
+type rxToken struct {
+	r    rune
+	kind byte
+}
+
+// ConstraintFromRegex converts a simple tag-filter regex, as found in CI
+// configuration and registry policies, into a Constraint:
+//
+//	^v1\.2\..*            ->  1.2.x
+//	^v?1\.\d+\.\d+$       ->  1.x
+//	^[vV]1\.2\.3$         ->  1.2.3
+//	^v1\..*|^v2\.0\..*    ->  1.x || 2.0.x
+//
+// Supported are top-level alternatives of an anchored ('^') optional 'v'
+// prefix followed by up to three dot separated components, each a literal
+// number or \d+ / [0-9]+, ending either with '$' after the third component
+// or with ".*" (or no '$') after a separating dot. Anything else (unanchored
+// patterns, unescaped '.' separators, character ranges, prerelease
+// suffixes) fails with an error wrapping ErrNotExpressible.
+//
+// The result follows constraint semantics where they are broader than the
+// regex: the 'v' prefix and build metadata are ignored and prereleases are
+// subject to Check's prerelease rule.
+func ConstraintFromRegex(expr string) (Constraint, error) {
+	var terms []string
+	for _, alt := range splitRegexAlternatives(expr) {
+		term, err := regexTerm(alt)
+		if err != nil {
+			return Constraint{}, fmt.Errorf("%w: %q: %s", ErrNotExpressible, alt, err.Error())
+		}
+		terms = append(terms, term)
+	}
+
+	c, ok := ParseConstraint(strings.Join(terms, " || "))
+	if !ok {
+		return Constraint{}, fmt.Errorf("%w: %q", ErrNotExpressible, expr)
+	}
+
+	return c, nil
+}
+
+// regexTerm converts one alternative into a constraint term.
+func regexTerm(alt string) (string, error) {
+	re, err := syntax.Parse(alt, syntax.Perl)
+	if err != nil {
+		return "", err
+	}
+
+	toks, err := regexTokens(re)
+	if err != nil {
+		return "", err
+	}
+
+	i := 0
+	next := func() rxToken {
+		if i < len(toks) {
+			return toks[i]
+		}
+		return rxToken{}
+	}
+
+	if next().kind != rxBegin {
+		return "", fmt.Errorf("pattern must be anchored with '^'")
+	}
+	i++
+
+	if t := next(); t.kind == rxPrefix || t.kind == rxLiteral && (t.r == 'v' || t.r == 'V') {
+		i++
+	}
+
+	var comps []string // literal numbers, "x" for \d+
+	tail := false
+	for len(comps) < 3 {
+		switch t := next(); {
+		case t.kind == rxNumber:
+			comps = append(comps, "x")
+			i++
+		case t.kind == rxLiteral && isDigit(byte(t.r)) && t.r < 0x80:
+			j := i
+			for j < len(toks) && toks[j].kind == rxLiteral && toks[j].r < 0x80 && isDigit(byte(toks[j].r)) {
+				j++
+			}
+			num := make([]byte, 0, j-i)
+			for _, d := range toks[i:j] {
+				num = append(num, byte(d.r))
+			}
+			if isBadNum(string(num)) {
+				return "", fmt.Errorf("number %s has leading zeros", num)
+			}
+			if len(comps) > 0 && comps[len(comps)-1] == "x" {
+				return "", fmt.Errorf("literal component after a wildcard")
+			}
+			comps = append(comps, string(num))
+			i = j
+		default:
+			return "", fmt.Errorf("want a number at position %d", len(comps)+1)
+		}
+
+		if len(comps) == 3 {
+			break
+		}
+
+		// separator, then either the next component or the tail
+		if t := next(); t.kind != rxLiteral || t.r != '.' {
+			if t.kind == rxEnd {
+				return "", fmt.Errorf("shorthand versions are not supported, want three components")
+			}
+			return "", fmt.Errorf("want an escaped '.' after component %d", len(comps))
+		}
+		i++
+		if t := next(); t.kind == rxTail || t.kind == 0 {
+			tail = true
+			i++
+			break
+		}
+	}
+
+	switch t := next(); {
+	case tail && (t.kind == rxEnd || t.kind == 0):
+	case !tail && t.kind == rxEnd:
+	case !tail && t.kind == rxTail:
+		return "", fmt.Errorf("'.*' after a number also matches longer numbers")
+	case !tail && t.kind == 0:
+		return "", fmt.Errorf("unterminated pattern also matches longer numbers, add '$'")
+	default:
+		return "", fmt.Errorf("unsupported suffix")
+	}
+	if i < len(toks)-1 {
+		return "", fmt.Errorf("unsupported suffix")
+	}
+
+	// trailing wildcards become an x-range
+	for len(comps) > 0 && comps[len(comps)-1] == "x" {
+		comps = comps[:len(comps)-1]
+	}
+	switch {
+	case len(comps) == 0:
+		return "*", nil
+	case len(comps) == 3:
+		return "=" + strings.Join(comps, "."), nil
+	}
+
+	return strings.Join(comps, ".") + ".x", nil
+}
+
+// regexTokens flattens a parsed regex into rxTokens.
+func regexTokens(re *syntax.Regexp) ([]rxToken, error) {
+	var out []rxToken
+
+	var walk func(re *syntax.Regexp) error
+	walk = func(re *syntax.Regexp) error {
+		switch re.Op {
+		case syntax.OpConcat:
+			for _, sub := range re.Sub {
+				if err := walk(sub); err != nil {
+					return err
+				}
+			}
+		case syntax.OpCapture:
+			return walk(re.Sub[0])
+		case syntax.OpBeginText, syntax.OpBeginLine:
+			out = append(out, rxToken{kind: rxBegin})
+		case syntax.OpEndText, syntax.OpEndLine:
+			out = append(out, rxToken{kind: rxEnd})
+		case syntax.OpLiteral:
+			// [vV] and (?i) parse as case-folded literals; digits and dots
+			// fold to themselves and the prefix is matched either way
+			for _, r := range re.Rune {
+				out = append(out, rxToken{kind: rxLiteral, r: r})
+			}
+		case syntax.OpCharClass:
+			return fmt.Errorf("unsupported character class %s", re)
+		case syntax.OpQuest:
+			sub := re.Sub[0]
+			if sub.Op == syntax.OpLiteral && len(sub.Rune) == 1 && (sub.Rune[0] == 'v' || sub.Rune[0] == 'V') || isPrefixClass(sub) {
+				out = append(out, rxToken{kind: rxPrefix})
+				return nil
+			}
+			return fmt.Errorf("unsupported optional %s", sub)
+		case syntax.OpPlus, syntax.OpStar:
+			sub := re.Sub[0]
+			switch {
+			case sub.Op == syntax.OpAnyChar || sub.Op == syntax.OpAnyCharNotNL:
+				out = append(out, rxToken{kind: rxTail})
+			case re.Op == syntax.OpPlus && isDigitClass(sub):
+				out = append(out, rxToken{kind: rxNumber})
+			default:
+				return fmt.Errorf("unsupported repetition %s", re)
+			}
+		case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+			return fmt.Errorf("unescaped '.' matches any character")
+		default:
+			return fmt.Errorf("unsupported construct %s", re)
+		}
+		return nil
+	}
+
+	return out, walk(re)
+}
+
+// isPrefixClass reports whether re is the character class [vV].
+func isPrefixClass(re *syntax.Regexp) bool {
+	return re.Op == syntax.OpCharClass && len(re.Rune) == 4 &&
+		re.Rune[0] == 'V' && re.Rune[1] == 'V' && re.Rune[2] == 'v' && re.Rune[3] == 'v'
+}
+
+// isDigitClass reports whether re is the character class [0-9].
+func isDigitClass(re *syntax.Regexp) bool {
+	return re.Op == syntax.OpCharClass && len(re.Rune) == 2 && re.Rune[0] == '0' && re.Rune[1] == '9'
+}
+
+// splitRegexAlternatives splits expr at top-level '|' (outside groups,
+// character classes and escapes).
+func splitRegexAlternatives(expr string) []string {
+	var out []string
+	depth, class, start := 0, false, 0
+	for i := 0; i < len(expr); i++ {
+		switch c := expr[i]; {
+		case c == '\\':
+			i++
+		case class:
+			class = c != ']'
+		case c == '[':
+			class = true
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == '|' && depth == 0:
+			out = append(out, expr[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(out, expr[start:])
+}
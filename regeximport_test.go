@@ -0,0 +1,50 @@
+package semver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConstraintFromRegex(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string // constraint String, "" if not expressible
+	}{
+		{`^v1\.2\..*`, "1.2.x"},
+		{`^v1\.2\.`, "1.2.x"},
+		{`^v?1\.\d+\.\d+$`, "1.x"},
+		{`^[vV]1\.2\.3$`, "=1.2.3"},
+		{`^1\.2\.[0-9]+$`, "1.2.x"},
+		{`^(v1\.2\..*)$`, "1.2.x"},
+		{`^v\d+\.\d+\.\d+$`, "*"},
+		{`^v.*`, ""},
+		{`^v1\..*|^v2\.0\..*`, "1.x || 2.0.x"},
+		{`^[vV]?10\..+`, "10.x"},
+
+		{`v1\.2\..*`, ""},
+		{`^v1.2.*`, ""},
+		{`^v1\.2.*`, ""},
+		{`^v1\.2`, ""},
+		{`^v1\.2$`, ""},
+		{`^v1\.2\.3-.*`, ""},
+		{`^v01\.2\.3$`, ""},
+		{`^v\d+\.2\.3$`, ""},
+		{`^v[1-3]\.0\.0$`, ""},
+		{`^v(1|2)\.0\.0$`, ""},
+		{`^(?i)V1\.2\.3$`, "=1.2.3"},
+		{`^v1\.2\.3$x`, ""},
+	}
+
+	for _, tt := range tests {
+		c, err := ConstraintFromRegex(tt.expr)
+		if tt.want == "" {
+			if !errors.Is(err, ErrNotExpressible) {
+				t.Errorf("ConstraintFromRegex(%q) = %q, %v, want ErrNotExpressible", tt.expr, c, err)
+			}
+			continue
+		}
+		if err != nil || c.String() != tt.want {
+			t.Errorf("ConstraintFromRegex(%q) = %q, %v, want %q", tt.expr, c, err, tt.want)
+		}
+	}
+}
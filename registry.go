@@ -0,0 +1,131 @@
+package semver
+
+import (
+	"context"
+	"sync"
+)
+
+// Registry is a storage-backed catalog of published versions keyed by name
+// (plugin, package, component). Implementations must be safe for concurrent use.
+//
+// Versions of equal precedence (differing only in build metadata) are the same
+// version for a registry: publishing "1.2.3+b" after "1.2.3" fails with
+// ErrVersionExists.
+type Registry interface {
+	// ListVersions returns the published, not yanked versions of name
+	// sorted in ascending semver order. Unknown names yield an empty List.
+	ListVersions(ctx context.Context, name string) (List, error)
+
+	// Publish adds v to name. Fails with ErrInvalidVersion for invalid v and
+	// ErrVersionExists if a version of equal precedence was already published
+	// (yanked versions included, they can not be re-published).
+	Publish(ctx context.Context, name string, v Semver) error
+
+	// Yank hides a published version of name from ListVersions.
+	// Fails with ErrVersionNotFound if it was never published.
+	Yank(ctx context.Context, name string, v Semver) error
+}
+
+// Resolve returns the highest version of name in r that satisfies c.
+// Fails with ErrNoMatch if none does.
+func Resolve(ctx context.Context, r Registry, name string, c Constraint) (Semver, error) {
+	ls, err := r.ListVersions(ctx, name)
+	if err != nil {
+		return Semver{}, err
+	}
+
+	for i := len(ls) - 1; i >= 0; i-- {
+		if c.Check(ls[i]) {
+			return ls[i], nil
+		}
+	}
+
+	return Semver{}, ErrNoMatch
+}
+
+// MemoryRegistry is an in-memory reference Registry implementation.
+// The zero value is ready to use.
+type MemoryRegistry struct {
+	entries map[string][]registryEntry
+	mu      sync.RWMutex
+}
+
+// registryEntry is a published version with its yank state.
+type registryEntry struct {
+	v      Semver
+	yanked bool
+}
+
+// NewMemoryRegistry returns an empty MemoryRegistry.
+func NewMemoryRegistry() *MemoryRegistry {
+	return &MemoryRegistry{}
+}
+
+// ListVersions implements Registry.
+func (r *MemoryRegistry) ListVersions(ctx context.Context, name string) (List, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ls := make(List, 0, len(r.entries[name]))
+	for _, e := range r.entries[name] {
+		if !e.yanked {
+			ls = append(ls, e.v)
+		}
+	}
+	ls.Sort()
+
+	return ls, nil
+}
+
+// Publish implements Registry.
+func (r *MemoryRegistry) Publish(ctx context.Context, name string, v Semver) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !v.Valid {
+		return ErrInvalidVersion
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, e := range r.entries[name] {
+		if e.v.IsEqual(v) {
+			return ErrVersionExists
+		}
+	}
+
+	if r.entries == nil {
+		r.entries = make(map[string][]registryEntry)
+	}
+	r.entries[name] = append(r.entries[name], registryEntry{v: v})
+
+	return nil
+}
+
+// Yank implements Registry.
+func (r *MemoryRegistry) Yank(ctx context.Context, name string, v Semver) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !v.Valid {
+		return ErrInvalidVersion
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := r.entries[name]
+	for i := range entries {
+		if entries[i].v.IsEqual(v) {
+			entries[i].yanked = true
+			return nil
+		}
+	}
+
+	return ErrVersionNotFound
+}
@@ -0,0 +1,57 @@
+package semver
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestMemoryRegistry covers publish dedup, yank and constraint resolution.
+func TestMemoryRegistry(t *testing.T) {
+	ctx := context.Background()
+	var r Registry = NewMemoryRegistry()
+
+	for _, s := range []string{"1.2.0", "1.0.0", "2.1.0-rc.1", "2.0.0"} {
+		v, _ := Parse(s)
+		if err := r.Publish(ctx, "plugin", v); err != nil {
+			t.Fatalf("Publish(%q): %v", s, err)
+		}
+	}
+
+	dup, _ := Parse("v1.2.0+rebuild")
+	if err := r.Publish(ctx, "plugin", dup); !errors.Is(err, ErrVersionExists) {
+		t.Fatalf("Publish duplicate: err = %v, want ErrVersionExists", err)
+	}
+	if err := r.Publish(ctx, "plugin", Semver{}); !errors.Is(err, ErrInvalidVersion) {
+		t.Fatalf("Publish invalid: err = %v, want ErrInvalidVersion", err)
+	}
+
+	c, _ := ParseConstraint("^1.0")
+	got, err := Resolve(ctx, r, "plugin", c)
+	if err != nil || got.Original != "1.2.0" {
+		t.Fatalf("Resolve(^1.0) = %q, %v; want 1.2.0", got.Original, err)
+	}
+
+	yank, _ := Parse("1.2.0")
+	if err := r.Yank(ctx, "plugin", yank); err != nil {
+		t.Fatalf("Yank: %v", err)
+	}
+	if err := r.Yank(ctx, "other", yank); !errors.Is(err, ErrVersionNotFound) {
+		t.Fatalf("Yank unknown: err = %v, want ErrVersionNotFound", err)
+	}
+
+	ls, _ := r.ListVersions(ctx, "plugin")
+	if len(ls) != 3 || ls[0].Original != "1.0.0" || ls[2].Original != "2.1.0-rc.1" {
+		t.Fatalf("ListVersions = %v", ls)
+	}
+
+	got, _ = Resolve(ctx, r, "plugin", c)
+	if got.Original != "1.0.0" {
+		t.Fatalf("Resolve after yank = %q, want 1.0.0", got.Original)
+	}
+
+	c, _ = ParseConstraint(">=3")
+	if _, err := Resolve(ctx, r, "plugin", c); !errors.Is(err, ErrNoMatch) {
+		t.Fatalf("Resolve(>=3): err = %v, want ErrNoMatch", err)
+	}
+}
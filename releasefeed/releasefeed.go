@@ -0,0 +1,159 @@
+/*
+Package releasefeed turns GitHub and GitLab release and tag API responses
+into semver lists, so update checkers need no forge-specific code.
+
+Supported documents (JSON arrays as returned by the REST APIs):
+
+	GitHub  GET /repos/{owner}/{repo}/releases   GitHubReleases
+	GitHub  GET /repos/{owner}/{repo}/tags       GitHubTags
+	GitLab  GET /projects/:id/releases           GitLabReleases
+	GitLab  GET /projects/:id/repository/tags    GitLabTags
+
+Drafts and upcoming releases are not published and never become versions.
+A release flagged as prerelease whose tag has no prerelease part gets the
+prerelease "pre", so flag and precedence agree ("v1.2.0" -> "v1.2.0-pre").
+*/
+package releasefeed
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/woozymasta/semver"
+)
+
+// FlaggedPrerelease is the prerelease given to versions of releases flagged
+// as prerelease whose tag carries none.
+const FlaggedPrerelease = "pre"
+
+// Release is a release or tag entry of a feed.
+type Release struct {
+	// PublishedAt is the release time; zero for tags and unpublished drafts.
+	PublishedAt time.Time
+
+	// Tag is the git tag name.
+	Tag string
+
+	// Prerelease reports the forge prerelease flag.
+	Prerelease bool
+
+	// Draft reports a draft (GitHub) or upcoming (GitLab) release.
+	Draft bool
+}
+
+// Version parses the tag of r with semver.ParsePrefixed and reports whether
+// it is a published version whose tag prefix equals prefix.
+// See the package documentation for the prerelease flag mapping.
+func (r Release) Version(prefix string) (semver.Semver, bool) {
+	if r.Draft {
+		return semver.Semver{}, false
+	}
+
+	p, v, ok := semver.ParsePrefixed(r.Tag)
+	if !ok || p != prefix {
+		return semver.Semver{}, false
+	}
+
+	if r.Prerelease && !v.HasPre() {
+		return v.WithPre(FlaggedPrerelease)
+	}
+
+	return v, true
+}
+
+// Versions returns the versions of the published releases with prefix,
+// in ascending semver order.
+func Versions(rs []Release, prefix string) semver.List {
+	ls := make(semver.List, 0, len(rs))
+	for _, r := range rs {
+		if v, ok := r.Version(prefix); ok {
+			ls = append(ls, v)
+		}
+	}
+	ls.Sort()
+
+	return ls
+}
+
+// GitHubReleases decodes a GitHub "List releases" response.
+func GitHubReleases(r io.Reader) ([]Release, error) {
+	var raw []struct {
+		PublishedAt *time.Time `json:"published_at"`
+		TagName     string     `json:"tag_name"`
+		Draft       bool       `json:"draft"`
+		Prerelease  bool       `json:"prerelease"`
+	}
+	if err := decode(r, &raw); err != nil {
+		return nil, err
+	}
+
+	out := make([]Release, len(raw))
+	for i, e := range raw {
+		out[i] = Release{Tag: e.TagName, Draft: e.Draft, Prerelease: e.Prerelease}
+		if e.PublishedAt != nil {
+			out[i].PublishedAt = *e.PublishedAt
+		}
+	}
+
+	return out, nil
+}
+
+// GitLabReleases decodes a GitLab "List releases" response.
+func GitLabReleases(r io.Reader) ([]Release, error) {
+	var raw []struct {
+		ReleasedAt      *time.Time `json:"released_at"`
+		TagName         string     `json:"tag_name"`
+		UpcomingRelease bool       `json:"upcoming_release"`
+	}
+	if err := decode(r, &raw); err != nil {
+		return nil, err
+	}
+
+	out := make([]Release, len(raw))
+	for i, e := range raw {
+		out[i] = Release{Tag: e.TagName, Draft: e.UpcomingRelease}
+		if e.ReleasedAt != nil {
+			out[i].PublishedAt = *e.ReleasedAt
+		}
+	}
+
+	return out, nil
+}
+
+// GitHubTags decodes a GitHub "List repository tags" response.
+func GitHubTags(r io.Reader) ([]Release, error) {
+	return tags(r)
+}
+
+// GitLabTags decodes a GitLab "List project repository tags" response.
+func GitLabTags(r io.Reader) ([]Release, error) {
+	return tags(r)
+}
+
+// tags decodes an array of objects with a "name" field into tag releases.
+func tags(r io.Reader) ([]Release, error) {
+	var raw []struct {
+		Name string `json:"name"`
+	}
+	if err := decode(r, &raw); err != nil {
+		return nil, err
+	}
+
+	out := make([]Release, len(raw))
+	for i, e := range raw {
+		out[i] = Release{Tag: e.Name}
+	}
+
+	return out, nil
+}
+
+// decode reads a JSON document from r into dst.
+func decode(r io.Reader, dst any) error {
+	if err := json.NewDecoder(r).Decode(dst); err != nil {
+		return fmt.Errorf("releasefeed: decode: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package releasefeed
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/woozymasta/semver"
+)
+
+// originals renders the Original strings of ls separated by spaces.
+func originals(ls semver.List) string {
+	s := make([]string, len(ls))
+	for i, v := range ls {
+		s[i] = v.Original
+	}
+
+	return strings.Join(s, " ")
+}
+
+func TestGitHubReleases(t *testing.T) {
+	rs, err := GitHubReleases(strings.NewReader(`[
+		{"tag_name":"v1.3.0","draft":true,"prerelease":false,"published_at":null},
+		{"tag_name":"v1.2.0","draft":false,"prerelease":true,"published_at":"2024-03-01T10:00:00Z"},
+		{"tag_name":"v1.2.0-rc.2","draft":false,"prerelease":true,"published_at":"2024-02-20T10:00:00Z"},
+		{"tag_name":"v1.1.0","draft":false,"prerelease":false,"published_at":"2024-01-01T10:00:00Z"},
+		{"tag_name":"nightly","draft":false,"prerelease":true,"published_at":"2024-01-02T10:00:00Z"}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !rs[0].Draft || !rs[0].PublishedAt.IsZero() {
+		t.Errorf("draft = %+v", rs[0])
+	}
+	if want := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC); !rs[1].PublishedAt.Equal(want) {
+		t.Errorf("PublishedAt = %v, want %v", rs[1].PublishedAt, want)
+	}
+
+	if got := originals(Versions(rs, "")); got != "v1.1.0 v1.2.0-pre v1.2.0-rc.2" {
+		t.Errorf("Versions = %q", got)
+	}
+}
+
+func TestGitLabReleases(t *testing.T) {
+	rs, err := GitLabReleases(strings.NewReader(`[
+		{"tag_name":"app-2.0.0","upcoming_release":true,"released_at":"2099-01-01T00:00:00Z"},
+		{"tag_name":"app-1.0.0","upcoming_release":false,"released_at":"2024-01-01T00:00:00Z"},
+		{"tag_name":"1.0.0","upcoming_release":false,"released_at":"2024-01-01T00:00:00Z"}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := originals(Versions(rs, "app-")); got != "1.0.0" {
+		t.Errorf("Versions = %q", got)
+	}
+}
+
+func TestTags(t *testing.T) {
+	const doc = `[{"name":"v0.2.0","commit":{"sha":"abc"}},{"name":"v0.10.0"},{"name":"latest"}]`
+
+	for name, parse := range map[string]func(r *strings.Reader) ([]Release, error){
+		"github": func(r *strings.Reader) ([]Release, error) { return GitHubTags(r) },
+		"gitlab": func(r *strings.Reader) ([]Release, error) { return GitLabTags(r) },
+	} {
+		rs, err := parse(strings.NewReader(doc))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if got := originals(Versions(rs, "")); got != "v0.2.0 v0.10.0" {
+			t.Errorf("%s: Versions = %q", name, got)
+		}
+	}
+
+	if _, err := GitHubTags(strings.NewReader(`{"message":"Not Found"}`)); err == nil {
+		t.Errorf("GitHubTags accepted an error document")
+	}
+}
@@ -0,0 +1,134 @@
+package semver
+
+import "strings"
+
+// FromCargo translates a Rust/Cargo version requirement into a Constraint.
+//
+// Requirements are comma separated and all must match. A bare version is a
+// caret requirement ("1.2" means "^1.2"), the other operators (^, ~, =, >,
+// >=, <, <=, wildcards) share the semantics of ParseConstraint. Cargo has no
+// "||", such input is rejected. String of the result is the translated
+// constraint ("^1.2, <1.5" -> "^1.2 <1.5").
+func FromCargo(s string) (Constraint, bool) {
+	if strings.TrimSpace(s) == "" || strings.Contains(s, "||") {
+		return Constraint{original: strings.TrimSpace(s)}, false
+	}
+
+	terms := strings.Split(s, ",")
+	for i, term := range terms {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			return Constraint{original: strings.TrimSpace(s)}, false
+		}
+		if term[0] >= '0' && term[0] <= '9' {
+			term = "^" + term
+		}
+		terms[i] = term
+	}
+
+	return ParseConstraint(strings.Join(terms, " "))
+}
+
+// FromPEP508 translates a Python PEP 508 / PEP 440 version specifier set
+// (">=1.2,<2", "~=1.4.2", "==1.4.*") into a Constraint. Versions are
+// converted with FromPEP440.
+//
+// Translations:
+//   - "~=1.4.2" -> ">=1.4.2 1.4.x", "~=1.4" -> ">=1.4.0 1.x";
+//   - "==1.4.*" -> "1.4.x", "==1.4" -> "=1.4.0";
+//   - "<1.4" -> "<1.4.0-0" (prereleases of the bound are excluded);
+//   - "!=", ">", ">=", "<=" map to the same operator.
+//
+// The package name, extras and environment markers of a full PEP 508
+// requirement are not accepted, nor are "===" and "!=" with a wildcard.
+func FromPEP508(s string) (Constraint, bool) {
+	in := strings.TrimSpace(s)
+	if in == "" {
+		return Constraint{original: in}, false
+	}
+
+	var terms []string
+	for _, spec := range strings.Split(in, ",") {
+		term, ok := pep508Term(strings.TrimSpace(spec))
+		if !ok {
+			return Constraint{original: in}, false
+		}
+		terms = append(terms, term)
+	}
+
+	return ParseConstraint(strings.Join(terms, " "))
+}
+
+// pep508Term translates one PEP 440 version specifier into constraint syntax.
+func pep508Term(spec string) (string, bool) {
+	op := ""
+	for _, p := range []string{"===", "~=", "==", "!=", "<=", ">=", "<", ">"} {
+		if strings.HasPrefix(spec, p) {
+			op = p
+			break
+		}
+	}
+	ver := strings.TrimSpace(spec[len(op):])
+
+	switch op {
+	case "", "===":
+		return "", false
+
+	case "==", "!=":
+		if prefix, ok := strings.CutSuffix(ver, ".*"); ok {
+			if op == "!=" || !isPEP508Prefix(prefix) {
+				return "", false
+			}
+			if strings.Count(prefix, ".") == 2 {
+				return prefix, true
+			}
+			return prefix + ".x", true
+		}
+	}
+
+	// constraints have no epoch, so "N!" versions can not be translated
+	v, ok := FromPEP440(ver)
+	if !ok || v.HasEpoch() {
+		return "", false
+	}
+	full := v.Print(PrintPrefixNoV | PrintMaskRelease | PrintPrerelease)
+
+	switch op {
+	case "==":
+		return "=" + full, true
+	case "~=":
+		release := strings.TrimLeft(ver, "vV")
+		if i := strings.IndexFunc(release, func(r rune) bool { return r != '.' && (r < '0' || r > '9') }); i >= 0 {
+			release = release[:i]
+		}
+		n := strings.Count(strings.TrimRight(release, "."), ".") + 1
+		if n < 2 {
+			return "", false
+		}
+		if n == 2 {
+			return ">=" + full + " " + v.Print(PrintPrefixNoV|PrintMajor) + ".x", true
+		}
+		return ">=" + full + " " + v.Print(PrintPrefixNoV|PrintMajor|PrintMinor) + ".x", true
+	case "<":
+		if !v.HasPre() {
+			return "<" + full + "-0", true
+		}
+	}
+
+	return op + full, true
+}
+
+// isPEP508Prefix reports whether s is a wildcard prefix "N[.N[.N]]".
+func isPEP508Prefix(s string) bool {
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return false
+	}
+	for _, p := range parts {
+		if p == "" || !isNum(p) {
+			return false
+		}
+	}
+
+	return true
+}
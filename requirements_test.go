@@ -0,0 +1,97 @@
+package semver
+
+import "testing"
+
+func TestFromCargo(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string // translated constraint, "" if rejected
+	}{
+		{"1.2", "^1.2"},
+		{"^1.2", "^1.2"},
+		{"~1.2.3", "~1.2.3"},
+		{"=1.2.3", "=1.2.3"},
+		{">= 1.2, < 1.5", ">= 1.2 < 1.5"},
+		{"0.3, !=0.3.4", "^0.3 !=0.3.4"},
+		{"*", "*"},
+		{"", ""},
+		{"1.2,", ""},
+		{"^1 || ^2", ""},
+		{"^bad", ""},
+	}
+
+	for _, tt := range tests {
+		c, ok := FromCargo(tt.in)
+		if tt.want == "" {
+			if ok {
+				t.Errorf("FromCargo(%q) accepted as %q", tt.in, c)
+			}
+			continue
+		}
+		if !ok || c.String() != tt.want {
+			t.Errorf("FromCargo(%q) = %q, %v, want %q", tt.in, c, ok, tt.want)
+		}
+	}
+
+	c, _ := FromCargo("0.2.3")
+	for v, want := range map[string]bool{"0.2.3": true, "0.2.9": true, "0.3.0": false, "0.2.2": false} {
+		if got := c.Check(MustParse(v)); got != want {
+			t.Errorf("FromCargo(0.2.3).Check(%s) = %v, want %v", v, got, want)
+		}
+	}
+}
+
+func TestFromPEP508(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string // translated constraint, "" if rejected
+	}{
+		{"~=1.4.2", ">=1.4.2 1.4.x"},
+		{"~=1.4", ">=1.4.0 1.x"},
+		{"~=1.4.0rc1", ">=1.4.0-rc.1 1.4.x"},
+		{"==1.4.*", "1.4.x"},
+		{"==1.4.2.*", "1.4.2"},
+		{"==1.4", "=1.4.0"},
+		{">=1.2, <2", ">=1.2.0 <2.0.0-0"},
+		{"<2.0.0b1", "<2.0.0-beta.1"},
+		{"!=1.4.1, >1.0", "!=1.4.1 >1.0.0"},
+		{">=1.4rc1", ">=1.4.0-rc.1"},
+		{"<2.0.post1", "<2.0.0-0"},
+		{"==1.0.dev1", "=1.0.0-dev.1"},
+		{"~=1.4rc1", ">=1.4.0-rc.1 1.x"},
+		{"~=1", ""},
+		{">=1!2.0", ""},
+		{"!=1.4.*", ""},
+		{"===1.0", ""},
+		{"1.0", ""},
+		{"==1.x.*", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		c, ok := FromPEP508(tt.in)
+		if tt.want == "" {
+			if ok {
+				t.Errorf("FromPEP508(%q) accepted as %q", tt.in, c)
+			}
+			continue
+		}
+		if !ok || c.String() != tt.want {
+			t.Errorf("FromPEP508(%q) = %q, %v, want %q", tt.in, c, ok, tt.want)
+		}
+	}
+
+	c, _ := FromPEP508("~=1.4.2")
+	for v, want := range map[string]bool{"1.4.2": true, "1.4.9": true, "1.5.0": false, "1.4.1": false} {
+		if got := c.Check(MustParse(v)); got != want {
+			t.Errorf("FromPEP508(~=1.4.2).Check(%s) = %v, want %v", v, got, want)
+		}
+	}
+
+	c, _ = FromPEP508(">=1.4rc1, <2.0")
+	for v, want := range map[string]bool{"1.4.0-rc.1": true, "1.4.0": true, "1.3.9": false, "2.0.0": false} {
+		if got := c.Check(MustParse(v)); got != want {
+			t.Errorf("FromPEP508(>=1.4rc1, <2.0).Check(%s) = %v, want %v", v, got, want)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package semver
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// resolutionHeader is the first line of an encoded Resolution.
+const resolutionHeader = "# semver resolution v1"
+
+// Requirement is a constraint on a package and who imposed it.
+// By is empty for requirements of the root (the project itself).
+type Requirement struct {
+	By         string
+	Constraint string
+}
+
+// Pin is the version chosen for a package together with the requirements
+// it was chosen for.
+type Pin struct {
+	Version      Semver
+	Requirements []Requirement
+}
+
+// Resolution maps package names to their pins: the lockfile of a
+// resolver built on Constraint.
+//
+// Encode writes it as deterministic text, sorted by name and requirement,
+// so equal resolutions encode byte for byte equal:
+//
+//	# semver resolution v1
+//	"libfoo" 1.4.2
+//		"" "^1.2.0"
+//		"app" ">=1.4.0 <2.0.0"
+type Resolution map[string]Pin
+
+// DriftKind classifies a Drift.
+type DriftKind uint8
+
+const (
+	DriftMissing     DriftKind = iota // pinned version no longer available
+	DriftUnsatisfied                  // pinned version violates a requirement
+	DriftOutdated                     // a higher version satisfies all requirements
+)
+
+// driftKindNames are the String forms of DriftKind.
+var driftKindNames = [...]string{
+	DriftMissing:     "missing",
+	DriftUnsatisfied: "unsatisfied",
+	DriftOutdated:    "outdated",
+}
+
+// String returns the lowercase name of k.
+func (k DriftKind) String() string {
+	if int(k) < len(driftKindNames) {
+		return driftKindNames[k]
+	}
+
+	return "unknown"
+}
+
+// Drift is a difference between a Resolution and the available versions,
+// see Resolution.Check. Latest is set for DriftOutdated and Requirement for
+// DriftUnsatisfied.
+type Drift struct {
+	Name        string
+	Pinned      Semver
+	Latest      Semver
+	Requirement Requirement
+	Kind        DriftKind
+}
+
+// String renders d for humans, e.g. `libfoo 1.4.2: outdated, 1.5.0 available`.
+func (d Drift) String() string {
+	prefix := d.Name + " " + d.Pinned.Original + ": " + d.Kind.String()
+	switch d.Kind {
+	case DriftUnsatisfied:
+		by := d.Requirement.By
+		if by == "" {
+			by = "root"
+		}
+		return prefix + ", " + by + " requires " + strconv.Quote(d.Requirement.Constraint)
+	case DriftOutdated:
+		return prefix + ", " + d.Latest.Original + " available"
+	}
+
+	return prefix
+}
+
+// Check compares the resolution with the versions available per package
+// and reports drift sorted by name: pins that are gone, pins violating a
+// recorded requirement (checked with Constraint.Check) and pins for which a
+// higher version satisfies every requirement. A pin with an unparsable
+// requirement is reported as DriftUnsatisfied. A pin without requirements
+// is treated as if it required ">=PIN", so by the prerelease rule of
+// Constraint.Check only prereleases of its own core count as newer
+// ("1.4.2" is not outdated by "2.0.0-rc.1", "2.0.0-rc.1" is by "2.0.0-rc.2").
+func (r Resolution) Check(available map[string]List) []Drift {
+	var out []Drift
+	for _, name := range r.names() {
+		pin := r[name]
+		list := available[name]
+
+		if !slices.ContainsFunc(list, func(v Semver) bool {
+			return v.IsEqual(pin.Version) && v.Build == pin.Version.Build
+		}) {
+			out = append(out, Drift{Name: name, Pinned: pin.Version, Kind: DriftMissing})
+		}
+
+		cs := make([]Constraint, 0, len(pin.Requirements))
+		unsatisfied := false
+		for _, req := range pin.Requirements {
+			c, ok := ParseConstraint(req.Constraint)
+			if !ok || !c.Check(pin.Version) {
+				out = append(out, Drift{Name: name, Pinned: pin.Version, Requirement: req, Kind: DriftUnsatisfied})
+				unsatisfied = true
+				continue
+			}
+			cs = append(cs, c)
+		}
+		if unsatisfied {
+			continue
+		}
+
+		latest := pin.Version
+		for _, v := range list {
+			if len(cs) == 0 && v.Flags&FlagHasPre != 0 && !pin.Version.EqualCore(v) {
+				continue
+			}
+			if v.IsGreater(latest) && !slices.ContainsFunc(cs, func(c Constraint) bool { return !c.Check(v) }) {
+				latest = v
+			}
+		}
+		if latest.IsGreater(pin.Version) {
+			out = append(out, Drift{Name: name, Pinned: pin.Version, Latest: latest, Kind: DriftOutdated})
+		}
+	}
+
+	return out
+}
+
+// Encode writes the resolution in its deterministic text form.
+// Versions are written as their Original (String when empty).
+func (r Resolution) Encode(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString(resolutionHeader + "\n")
+
+	for _, name := range r.names() {
+		pin := r[name]
+		ver := pin.Version.Original
+		if ver == "" {
+			ver = pin.Version.String()
+		}
+		fmt.Fprintf(bw, "%s %s\n", strconv.Quote(name), ver)
+
+		reqs := slices.Clone(pin.Requirements)
+		slices.SortFunc(reqs, func(a, b Requirement) int {
+			if c := strings.Compare(a.By, b.By); c != 0 {
+				return c
+			}
+			return strings.Compare(a.Constraint, b.Constraint)
+		})
+		for _, req := range slices.Compact(reqs) {
+			fmt.Fprintf(bw, "\t%s %s\n", strconv.Quote(req.By), strconv.Quote(req.Constraint))
+		}
+	}
+
+	return bw.Flush()
+}
+
+// DecodeResolution reads a resolution written by Encode. Invalid versions
+// and constraints fail with an error wrapping ErrInvalidVersion or
+// ErrInvalidConstraint; other syntax errors name the offending line.
+func DecodeResolution(rd io.Reader) (Resolution, error) {
+	sc := bufio.NewScanner(rd)
+	r := make(Resolution)
+
+	lineNo, name := 0, ""
+	for sc.Scan() {
+		lineNo++
+		line := sc.Text()
+
+		if lineNo == 1 {
+			if line != resolutionHeader {
+				return nil, fmt.Errorf("semver: resolution line 1: want %q", resolutionHeader)
+			}
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if rest, ok := strings.CutPrefix(line, "\t"); ok {
+			by, c, err := unquotePair(rest)
+			if err != nil || name == "" {
+				return nil, fmt.Errorf("semver: resolution line %d: malformed requirement", lineNo)
+			}
+			if _, ok := ParseConstraint(c); !ok {
+				return nil, fmt.Errorf("%w: %q (line %d)", ErrInvalidConstraint, c, lineNo)
+			}
+
+			pin := r[name]
+			pin.Requirements = append(pin.Requirements, Requirement{By: by, Constraint: c})
+			r[name] = pin
+			continue
+		}
+
+		n, ver, err := unquoteHead(line)
+		if err != nil {
+			return nil, fmt.Errorf("semver: resolution line %d: malformed package", lineNo)
+		}
+		v, ok := Parse(ver)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q (line %d)", ErrInvalidVersion, ver, lineNo)
+		}
+		if _, dup := r[n]; dup {
+			return nil, fmt.Errorf("semver: resolution line %d: duplicate package %q", lineNo, n)
+		}
+
+		name = n
+		r[name] = Pin{Version: v}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if lineNo == 0 {
+		return nil, fmt.Errorf("semver: resolution: empty input")
+	}
+
+	return r, nil
+}
+
+// names returns the package names in sorted order.
+func (r Resolution) names() []string {
+	names := make([]string, 0, len(r))
+	for name := range r {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// unquoteHead splits `"quoted" rest` into the unquoted string and rest.
+func unquoteHead(s string) (string, string, error) {
+	q, err := strconv.QuotedPrefix(s)
+	if err != nil {
+		return "", "", err
+	}
+	rest, ok := strings.CutPrefix(s[len(q):], " ")
+	if !ok {
+		return "", "", strconv.ErrSyntax
+	}
+	u, err := strconv.Unquote(q)
+
+	return u, rest, err
+}
+
+// unquotePair parses `"a" "b"`.
+func unquotePair(s string) (string, string, error) {
+	a, rest, err := unquoteHead(s)
+	if err != nil {
+		return "", "", err
+	}
+	b, err := strconv.Unquote(rest)
+
+	return a, b, err
+}
@@ -0,0 +1,99 @@
+package semver
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestResolution_EncodeDecode(t *testing.T) {
+	r := Resolution{
+		"libfoo": {Version: MustParse("1.4.2"), Requirements: []Requirement{
+			{By: "app", Constraint: ">=1.4.0 <2.0.0"},
+			{By: "", Constraint: "^1.2.0"},
+			{By: "app", Constraint: ">=1.4.0 <2.0.0"},
+		}},
+		`odd "name"`: {Version: MustParse("v0.1.0-rc.1+b.7")},
+	}
+
+	var b strings.Builder
+	if err := r.Encode(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := "# semver resolution v1\n" +
+		"\"libfoo\" 1.4.2\n" +
+		"\t\"\" \"^1.2.0\"\n" +
+		"\t\"app\" \">=1.4.0 <2.0.0\"\n" +
+		"\"odd \\\"name\\\"\" v0.1.0-rc.1+b.7\n"
+	if b.String() != want {
+		t.Fatalf("Encode =\n%s\nwant\n%s", b.String(), want)
+	}
+
+	back, err := DecodeResolution(strings.NewReader(b.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b2 strings.Builder
+	if err := back.Encode(&b2); err != nil || b2.String() != want {
+		t.Errorf("round trip = %q, %v", b2.String(), err)
+	}
+	if p := back[`odd "name"`]; p.Version.Build != "b.7" || len(p.Requirements) != 0 {
+		t.Errorf("decoded pin = %+v", p)
+	}
+}
+
+func TestDecodeResolution_Errors(t *testing.T) {
+	tests := []struct {
+		in   string
+		want error // nil for a generic error
+	}{
+		{"", nil},
+		{"# other\n", nil},
+		{"# semver resolution v1\n\"a\" bogus\n", ErrInvalidVersion},
+		{"# semver resolution v1\n\"a\" 1.0.0\n\t\"\" \">=bad\"\n", ErrInvalidConstraint},
+		{"# semver resolution v1\n\t\"\" \"^1.0.0\"\n", nil},
+		{"# semver resolution v1\na 1.0.0\n", nil},
+		{"# semver resolution v1\n\"a\" 1.0.0\n\"a\" 1.0.1\n", nil},
+	}
+
+	for _, tt := range tests {
+		_, err := DecodeResolution(strings.NewReader(tt.in))
+		if err == nil || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("DecodeResolution(%q) = %v, want %v", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestResolution_Check(t *testing.T) {
+	r := Resolution{
+		"current":  {Version: MustParse("1.2.0"), Requirements: []Requirement{{Constraint: "^1.0.0"}}},
+		"gone":     {Version: MustParse("1.0.0")},
+		"outdated": {Version: MustParse("1.2.0"), Requirements: []Requirement{{Constraint: "^1.0.0"}, {By: "lib", Constraint: "<1.4.0"}}},
+		"violated": {Version: MustParse("2.0.0"), Requirements: []Requirement{{By: "lib", Constraint: "^1.0.0"}}},
+		"stable":   {Version: MustParse("1.4.2")},
+		"next":     {Version: MustParse("2.0.0-rc.1")},
+	}
+	available := map[string]List{
+		"current":  mustList("1.0.0", "1.2.0", "2.0.0", "1.3.0-rc.1"),
+		"gone":     mustList("1.0.1"),
+		"outdated": mustList("1.2.0", "1.3.0", "1.4.0"),
+		"violated": mustList("1.9.0", "2.0.0"),
+		"stable":   mustList("1.4.2", "2.0.0-rc.1"),
+		"next":     mustList("2.0.0-rc.1", "2.0.0-rc.2", "2.1.0-beta.1"),
+	}
+
+	var got []string
+	for _, d := range r.Check(available) {
+		got = append(got, d.String())
+	}
+	want := []string{
+		"gone 1.0.0: missing",
+		"gone 1.0.0: outdated, 1.0.1 available",
+		"next 2.0.0-rc.1: outdated, 2.0.0-rc.2 available",
+		"outdated 1.2.0: outdated, 1.3.0 available",
+		`violated 2.0.0: unsatisfied, lib requires "^1.0.0"`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Check =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
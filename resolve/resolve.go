@@ -0,0 +1,328 @@
+/*
+Package resolve picks a mutually compatible set of package versions from
+an index of releases and their dependency constraints.
+
+Resolve runs a backtracking search: it always decides the package with
+the fewest remaining candidates next and tries candidates from the
+highest version down, so the first solution found prefers newer versions.
+Constraints are checked with semver.Constraint.Check, prereleases
+included only where a constraint opts into them. The result is a
+semver.Resolution, ready to be encoded as a lockfile.
+
+When no solution exists the error is a *ConflictError naming the package
+whose requirements could not be met together, reduced to a minimal set of
+conflicting requirements.
+*/
+package resolve
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/woozymasta/semver"
+)
+
+// Dependency is a constraint on another package.
+type Dependency struct {
+	Name       string
+	Constraint string
+}
+
+// Release is a published version of a package with its dependencies.
+type Release struct {
+	Version      semver.Semver
+	Dependencies []Dependency
+}
+
+// Index maps package names to their releases, in any order.
+// Invalid versions are ignored.
+type Index map[string][]Release
+
+// Requirement is a constraint imposed on package Name, either by the root
+// (By is empty, Version invalid) or by release Version of package By.
+type Requirement struct {
+	Name       string
+	By         string
+	Version    semver.Semver
+	Constraint semver.Constraint
+}
+
+// String renders r for humans, e.g. `app 1.2.0 requires lib >=2.0.0`.
+func (r Requirement) String() string {
+	by := "root"
+	if r.By != "" {
+		by = r.By + " " + r.Version.Original
+	}
+
+	return by + " requires " + r.Name + " " + r.Constraint.String()
+}
+
+// ConflictError reports that no release of Package satisfies all of
+// Requirements at once. Requirements is minimal where possible: each one
+// is needed for the conflict, so "root requires lib ^1.0.0; app 1.0.0
+// requires lib ^2.0.0" is not buried among compatible requirements on lib.
+// It matches semver.ErrNoMatch with errors.Is.
+type ConflictError struct {
+	Package      string
+	Requirements []Requirement
+}
+
+// Error implements error.
+func (e *ConflictError) Error() string {
+	if len(e.Requirements) == 1 {
+		return fmt.Sprintf("resolve: no version of %q satisfies %s", e.Package, e.Requirements[0])
+	}
+
+	reqs := make([]string, len(e.Requirements))
+	for i, r := range e.Requirements {
+		reqs[i] = r.String()
+	}
+
+	return fmt.Sprintf("resolve: no version of %q satisfies: %s", e.Package, strings.Join(reqs, "; "))
+}
+
+// Unwrap returns semver.ErrNoMatch.
+func (e *ConflictError) Unwrap() error {
+	return semver.ErrNoMatch
+}
+
+// release is a Release with parsed dependency constraints.
+type release struct {
+	v    semver.Semver
+	deps []Requirement
+}
+
+// solver holds the search state of Resolve.
+type solver struct {
+	index    map[string][]release
+	reqs     map[string][]Requirement
+	chosen   map[string]int
+	conflict *ConflictError
+	depth    int
+	unsat    bool
+}
+
+// Resolve selects one release of every package reachable from root such
+// that all requirements hold, preferring higher versions. It fails with an
+// error wrapping semver.ErrInvalidConstraint for unparsable constraints in
+// root or index, and with a *ConflictError when the requirements can not
+// be satisfied; the conflict reported is the one met deepest in the
+// search.
+func Resolve(index Index, root []Dependency) (semver.Resolution, error) {
+	s := &solver{
+		index:  make(map[string][]release, len(index)),
+		reqs:   make(map[string][]Requirement),
+		chosen: make(map[string]int),
+	}
+
+	for name, rels := range index {
+		out := make([]release, 0, len(rels))
+		for _, rel := range rels {
+			if !rel.Version.Valid {
+				continue
+			}
+			deps, err := requirements(name, rel.Version, rel.Dependencies)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, release{v: rel.Version, deps: deps})
+		}
+		slices.SortStableFunc(out, func(a, b release) int {
+			return semver.CompareForSort(b.v, a.v)
+		})
+		s.index[name] = out
+	}
+
+	deps, err := requirements("", semver.Semver{}, root)
+	if err != nil {
+		return nil, err
+	}
+	s.push(deps)
+
+	if !s.solve() {
+		if s.unsat {
+			s.conflict.Requirements = s.minimize(s.conflict.Package, s.conflict.Requirements)
+		}
+		return nil, s.conflict
+	}
+
+	res := make(semver.Resolution, len(s.chosen))
+	for name, i := range s.chosen {
+		pin := semver.Pin{Version: s.index[name][i].v}
+		for _, r := range s.reqs[name] {
+			pin.Requirements = append(pin.Requirements, semver.Requirement{By: r.By, Constraint: r.Constraint.String()})
+		}
+		res[name] = pin
+	}
+
+	return res, nil
+}
+
+// requirements parses the dependencies of release v of package by.
+func requirements(by string, v semver.Semver, deps []Dependency) ([]Requirement, error) {
+	out := make([]Requirement, len(deps))
+	for i, d := range deps {
+		c, ok := semver.ParseConstraint(d.Constraint)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q (%s dependency of %s)",
+				semver.ErrInvalidConstraint, d.Constraint, d.Name, orRoot(by, v))
+		}
+		out[i] = Requirement{Name: d.Name, By: by, Version: v, Constraint: c}
+	}
+
+	return out, nil
+}
+
+// solve decides the remaining packages; it reports whether a solution was
+// found and leaves the state at that solution.
+func (s *solver) solve() bool {
+	// most constrained undecided package first, ties by name
+	name, cands, found := "", []int(nil), false
+	for _, n := range s.pending() {
+		c := s.candidates(n)
+		if !found || len(c) < len(cands) {
+			name, cands, found = n, c, true
+		}
+	}
+	if !found {
+		return true
+	}
+	if len(cands) == 0 {
+		s.fail(name)
+		return false
+	}
+
+	for _, i := range cands {
+		rel := s.index[name][i]
+		s.chosen[name] = i
+		if s.push(rel.deps) && s.solve() {
+			return true
+		}
+		s.pop(rel.deps)
+		delete(s.chosen, name)
+	}
+
+	return false
+}
+
+// pending returns the sorted names of required but undecided packages.
+func (s *solver) pending() []string {
+	var names []string
+	for n := range s.reqs {
+		if _, ok := s.chosen[n]; !ok {
+			names = append(names, n)
+		}
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// candidates returns the indexes of the releases of name satisfying all
+// current requirements, highest version first.
+func (s *solver) candidates(name string) []int {
+	var out []int
+	for i, rel := range s.index[name] {
+		if s.satisfies(name, rel.v) {
+			out = append(out, i)
+		}
+	}
+
+	return out
+}
+
+// satisfies reports whether v meets every requirement on name.
+func (s *solver) satisfies(name string, v semver.Semver) bool {
+	return satisfiesAll(s.reqs[name], v)
+}
+
+// satisfiesAll reports whether v meets every requirement of reqs.
+func satisfiesAll(reqs []Requirement, v semver.Semver) bool {
+	for _, r := range reqs {
+		if !r.Constraint.Check(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// unsatisfiable reports whether no release of name meets all of reqs.
+func (s *solver) unsatisfiable(name string, reqs []Requirement) bool {
+	for _, rel := range s.index[name] {
+		if satisfiesAll(reqs, rel.v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// minimize shrinks reqs, unsatisfiable for name, to a minimal subset that
+// is still unsatisfiable: dropping any one requirement of the result
+// leaves a release of name that meets the rest. A package without releases
+// keeps one requirement to show who asked for it.
+func (s *solver) minimize(name string, reqs []Requirement) []Requirement {
+	core := slices.Clone(reqs)
+	for i := 0; i < len(core) && len(core) > 1; {
+		trial := slices.Delete(slices.Clone(core), i, i+1)
+		if s.unsatisfiable(name, trial) {
+			core = trial
+		} else {
+			i++
+		}
+	}
+
+	return core
+}
+
+// push adds requirements and reports whether every already decided
+// package still satisfies them. Requirements are added even on failure;
+// pop removes them.
+func (s *solver) push(deps []Requirement) bool {
+	ok := true
+	for _, d := range deps {
+		s.reqs[d.Name] = append(s.reqs[d.Name], d)
+		if i, decided := s.chosen[d.Name]; decided && ok && !d.Constraint.Check(s.index[d.Name][i].v) {
+			s.fail(d.Name)
+			ok = false
+		}
+	}
+
+	return ok
+}
+
+// pop removes requirements added by push.
+func (s *solver) pop(deps []Requirement) {
+	for i := len(deps) - 1; i >= 0; i-- {
+		name := deps[i].Name
+		s.reqs[name] = s.reqs[name][:len(s.reqs[name])-1]
+		if len(s.reqs[name]) == 0 {
+			delete(s.reqs, name)
+		}
+	}
+}
+
+// fail records a conflict on name. Conflicts where no release of name
+// meets the requirements at all win over conflicts with an already decided
+// release; among those of one kind the deepest (most packages decided) is
+// kept.
+func (s *solver) fail(name string) {
+	unsat := s.unsatisfiable(name, s.reqs[name])
+	if s.conflict != nil && (s.unsat && !unsat || s.unsat == unsat && len(s.chosen) <= s.depth) {
+		return
+	}
+
+	s.conflict = &ConflictError{Package: name, Requirements: slices.Clone(s.reqs[name])}
+	s.depth, s.unsat = len(s.chosen), unsat
+}
+
+// orRoot names the requirer of a dependency in errors.
+func orRoot(by string, v semver.Semver) string {
+	if by == "" {
+		return "root"
+	}
+
+	return by + " " + v.Original
+}
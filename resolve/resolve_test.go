@@ -0,0 +1,144 @@
+package resolve
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/woozymasta/semver"
+)
+
+// rel builds a Release from a version and "name constraint" pairs.
+func rel(v string, deps ...string) Release {
+	r := Release{Version: semver.MustParse(v)}
+	for _, d := range deps {
+		name, c, _ := strings.Cut(d, " ")
+		r.Dependencies = append(r.Dependencies, Dependency{Name: name, Constraint: c})
+	}
+
+	return r
+}
+
+// pins renders a resolution as sorted "name@version" pairs.
+func pins(r semver.Resolution) string {
+	var b strings.Builder
+	if err := r.Encode(&b); err != nil {
+		return err.Error()
+	}
+
+	var out []string
+	for _, line := range strings.Split(b.String(), "\n")[1:] {
+		if line != "" && line[0] == '"' {
+			name, v, _ := strings.Cut(line, " ")
+			out = append(out, strings.Trim(name, `"`)+"@"+v)
+		}
+	}
+
+	return strings.Join(out, " ")
+}
+
+func TestResolve(t *testing.T) {
+	index := Index{
+		"app":  {rel("1.0.0", "lib ^1.0.0"), rel("2.0.0", "lib ^2.0.0", "log ^1.0.0")},
+		"lib":  {rel("1.0.0"), rel("1.4.0", "log ^1.1.0"), rel("2.0.0", "log ^2.0.0"), rel("2.1.0-rc.1")},
+		"log":  {rel("1.0.0"), rel("1.1.0"), rel("1.2.0"), rel("2.0.0")},
+		"cyc":  {rel("1.0.0", "cyc2 *")},
+		"cyc2": {rel("1.0.0", "cyc ^1.0.0")},
+	}
+
+	tests := []struct {
+		name string
+		root []Dependency
+		want string // pins, or the conflicting package prefixed with '!'
+	}{
+		{"highest", []Dependency{{"lib", "*"}}, "lib@2.0.0 log@2.0.0"},
+		{"transitive", []Dependency{{"app", "^1.0.0"}}, "app@1.0.0 lib@1.4.0 log@1.2.0"},
+		// app 2.0.0 needs lib ^2 which needs log ^2, but app also needs log ^1:
+		// backtrack to app 1.0.0
+		{"backtrack", []Dependency{{"app", "*"}}, "app@1.0.0 lib@1.4.0 log@1.2.0"},
+		{"root pins", []Dependency{{"app", "*"}, {"log", "1.1.0"}}, "app@1.0.0 lib@1.4.0 log@1.1.0"},
+		{"cycle", []Dependency{{"cyc", "*"}}, "cyc@1.0.0 cyc2@1.0.0"},
+		{"empty", nil, ""},
+
+		{"conflict", []Dependency{{"lib", "^2.0.0"}, {"log", "^1.0.0"}}, "!log"},
+		{"unknown", []Dependency{{"nope", "*"}}, "!nope"},
+	}
+
+	for _, tt := range tests {
+		res, err := Resolve(index, tt.root)
+		if name, ok := strings.CutPrefix(tt.want, "!"); ok {
+			var ce *ConflictError
+			if !errors.As(err, &ce) || ce.Package != name || !errors.Is(err, semver.ErrNoMatch) {
+				t.Errorf("%s: Resolve = %v, %v, want conflict on %s", tt.name, pins(res), err, name)
+			}
+			continue
+		}
+		if err != nil || pins(res) != tt.want {
+			t.Errorf("%s: Resolve = %q, %v, want %q", tt.name, pins(res), err, tt.want)
+		}
+	}
+}
+
+func TestResolve_Provenance(t *testing.T) {
+	index := Index{
+		"app": {rel("1.0.0", "lib >=1.1.0")},
+		"lib": {rel("1.0.0"), rel("1.2.0")},
+	}
+
+	res, err := Resolve(index, []Dependency{{"app", "^1.0.0"}, {"lib", "^1.0.0"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := res["lib"].Requirements
+	if len(got) != 2 || got[0] != (semver.Requirement{Constraint: "^1.0.0"}) ||
+		got[1] != (semver.Requirement{By: "app", Constraint: ">=1.1.0"}) {
+		t.Errorf("lib requirements = %+v", got)
+	}
+}
+
+func TestResolve_Errors(t *testing.T) {
+	index := Index{"app": {rel("1.0.0", "lib >=bad")}, "lib": {rel("1.0.0")}}
+
+	if _, err := Resolve(index, []Dependency{{"app", "*"}}); !errors.Is(err, semver.ErrInvalidConstraint) {
+		t.Errorf("Resolve(bad index) = %v, want ErrInvalidConstraint", err)
+	}
+	if _, err := Resolve(Index{}, []Dependency{{"app", "1.x.y"}}); !errors.Is(err, semver.ErrInvalidConstraint) {
+		t.Errorf("Resolve(bad root) = %v, want ErrInvalidConstraint", err)
+	}
+
+	_, err := Resolve(Index{"lib": {rel("1.0.0"), rel("2.0.0")}, "app": {rel("1.0.0", "lib ^2.0.0")}},
+		[]Dependency{{"app", "*"}, {"lib", "^1.0.0"}})
+	want := `resolve: no version of "lib" satisfies: root requires lib ^1.0.0; app 1.0.0 requires lib ^2.0.0`
+	if err == nil || err.Error() != want {
+		t.Errorf("Resolve = %v, want %s", err, want)
+	}
+}
+
+func TestResolve_MinimalConflict(t *testing.T) {
+	index := Index{
+		"lib":   {rel("1.0.0"), rel("1.5.0"), rel("2.0.0")},
+		"app":   {rel("1.0.0", "lib ^1.0.0")},
+		"tool":  {rel("1.0.0", "lib >=2.0.0")},
+		"other": {rel("1.0.0", "lib <3.0.0")},
+	}
+
+	_, err := Resolve(index, []Dependency{{"lib", ">=1.0.0"}, {"other", "*"}, {"app", "*"}, {"tool", "*"}})
+	var ce *ConflictError
+	if !errors.As(err, &ce) {
+		t.Fatalf("Resolve = %v, want ConflictError", err)
+	}
+
+	var got []string
+	for _, r := range ce.Requirements {
+		got = append(got, r.String())
+	}
+	if want := "app 1.0.0 requires lib ^1.0.0; tool 1.0.0 requires lib >=2.0.0"; strings.Join(got, "; ") != want {
+		t.Errorf("conflict = %q, want %q", strings.Join(got, "; "), want)
+	}
+
+	_, err = Resolve(index, []Dependency{{"nope", "^1.0.0"}, {"app", "*"}})
+	if want := `resolve: no version of "nope" satisfies root requires nope ^1.0.0`; err == nil || err.Error() != want {
+		t.Errorf("Resolve = %v, want %s", err, want)
+	}
+}
@@ -0,0 +1,85 @@
+package semver
+
+import "slices"
+
+// RetentionPolicy selects which published versions a registry or artifact
+// cleanup job keeps, e.g. "last 3 patches of every minor, everything newer
+// than v2.0.0 and the newest release of each major":
+//
+//	RetentionPolicy{
+//		PatchesPerMinor: 3,
+//		KeepNewerThan:   semver.MustParse("v2.0.0"),
+//		LatestPerMajor:  true,
+//	}
+//
+// A version is kept if any rule keeps it. The per-line rules count stable
+// releases only, so prereleases are deleted unless KeepNewerThan keeps them.
+// Invalid versions are always kept: a cleanup job should not delete what it
+// can not parse. A zero policy deletes every valid version.
+type RetentionPolicy struct {
+	// PatchesPerMinor is the number of newest releases kept per
+	// MAJOR.MINOR line.
+	PatchesPerMinor int
+
+	// KeepNewerThan keeps every version (prereleases included) with higher
+	// precedence. Ignored when invalid.
+	KeepNewerThan Semver
+
+	// LatestPerMajor keeps the newest release of each MAJOR line.
+	LatestPerMajor bool
+}
+
+// Apply splits ls into the versions to keep and to delete.
+// Both parts keep the input order.
+func (p RetentionPolicy) Apply(ls List) (keep, del List) {
+	kept := make([]bool, len(ls))
+
+	lines := make(map[[2]int][]int)
+	majors := make(map[int]int)
+	for i, v := range ls {
+		switch {
+		case !v.Valid:
+			kept[i] = true
+			continue
+		case p.KeepNewerThan.Valid && v.IsGreater(p.KeepNewerThan):
+			kept[i] = true
+		}
+		if v.HasPre() {
+			continue
+		}
+
+		key := [2]int{v.Major, v.Minor}
+		lines[key] = append(lines[key], i)
+
+		if j, ok := majors[v.Major]; !ok || v.IsGreater(ls[j]) {
+			majors[v.Major] = i
+		}
+	}
+
+	if p.PatchesPerMinor > 0 {
+		for _, idx := range lines {
+			slices.SortFunc(idx, func(a, b int) int {
+				return CompareForSort(ls[b], ls[a])
+			})
+			for _, i := range idx[:min(p.PatchesPerMinor, len(idx))] {
+				kept[i] = true
+			}
+		}
+	}
+
+	if p.LatestPerMajor {
+		for _, i := range majors {
+			kept[i] = true
+		}
+	}
+
+	for i, v := range ls {
+		if kept[i] {
+			keep = append(keep, v)
+		} else {
+			del = append(del, v)
+		}
+	}
+
+	return keep, del
+}
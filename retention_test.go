@@ -0,0 +1,58 @@
+package semver
+
+import "testing"
+
+func TestRetentionPolicy_Apply(t *testing.T) {
+	ls := mustList(
+		"v1.0.0", "v1.0.1", "v1.1.0", "v1.1.1", "v1.1.2", "v1.1.3-rc.1",
+		"v2.0.0", "v2.0.1", "v2.1.0-rc.1", "bogus",
+	)
+
+	tests := []struct {
+		name   string
+		policy RetentionPolicy
+		keep   string
+		delete string
+	}{
+		{
+			"zero",
+			RetentionPolicy{},
+			"bogus",
+			"v1.0.0 v1.0.1 v1.1.0 v1.1.1 v1.1.2 v1.1.3-rc.1 v2.0.0 v2.0.1 v2.1.0-rc.1",
+		},
+		{
+			"patches per minor",
+			RetentionPolicy{PatchesPerMinor: 2},
+			"v1.0.0 v1.0.1 v1.1.1 v1.1.2 v2.0.0 v2.0.1 bogus",
+			"v1.1.0 v1.1.3-rc.1 v2.1.0-rc.1",
+		},
+		{
+			"newer than",
+			RetentionPolicy{KeepNewerThan: MustParse("v1.1.2")},
+			"v1.1.3-rc.1 v2.0.0 v2.0.1 v2.1.0-rc.1 bogus",
+			"v1.0.0 v1.0.1 v1.1.0 v1.1.1 v1.1.2",
+		},
+		{
+			"latest per major",
+			RetentionPolicy{LatestPerMajor: true},
+			"v1.1.2 v2.0.1 bogus",
+			"v1.0.0 v1.0.1 v1.1.0 v1.1.1 v1.1.3-rc.1 v2.0.0 v2.1.0-rc.1",
+		},
+		{
+			"combined",
+			RetentionPolicy{PatchesPerMinor: 1, KeepNewerThan: MustParse("v2.0.0"), LatestPerMajor: true},
+			"v1.0.1 v1.1.2 v2.0.1 v2.1.0-rc.1 bogus",
+			"v1.0.0 v1.1.0 v1.1.1 v1.1.3-rc.1 v2.0.0",
+		},
+	}
+
+	for _, tt := range tests {
+		keep, del := tt.policy.Apply(ls)
+		if got := listOriginals(keep); got != tt.keep {
+			t.Errorf("%s: keep = %q, want %q", tt.name, got, tt.keep)
+		}
+		if got := listOriginals(del); got != tt.delete {
+			t.Errorf("%s: delete = %q, want %q", tt.name, got, tt.delete)
+		}
+	}
+}
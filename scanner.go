@@ -0,0 +1,129 @@
+package semver
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ScanInvalid selects how a Scanner handles lines that are not versions.
+type ScanInvalid uint8
+
+const (
+	ScanReport ScanInvalid = iota // yield the line with an error and continue
+	ScanSkip                      // drop the line silently
+	ScanStop                      // stop scanning; Err returns the error
+)
+
+// ScannerOptions configures NewScannerWith.
+// The zero value trims lines, skips blank ones, has no comment syntax and
+// reports invalid lines.
+type ScannerOptions struct {
+	// Parse selects the accepted input forms, see ParseWith.
+	Parse ParseOptions
+
+	// CommentPrefix skips lines starting with it after trimming ("#" for
+	// hand-written tag lists); empty disables comments.
+	CommentPrefix string
+
+	// KeepBlank yields blank lines as invalid versions, handled per Invalid,
+	// instead of skipping them.
+	KeepBlank bool
+
+	// Invalid selects the handling of lines that do not parse.
+	Invalid ScanInvalid
+}
+
+// Scanner reads versions from an io.Reader, one per line, as printed by
+// `git tag` and similar tools. Surrounding whitespace is trimmed. Use it
+// like bufio.Scanner:
+//
+//	sc := semver.NewScanner(os.Stdin)
+//	for sc.Scan() {
+//		v, err := sc.Version()
+//		...
+//	}
+//	if err := sc.Err(); err != nil {
+//		...
+//	}
+type Scanner struct {
+	sc   *bufio.Scanner
+	err  error
+	v    Semver
+	verr error
+	opts ScannerOptions
+	line int
+}
+
+// NewScanner returns a Scanner reading r with the zero ScannerOptions.
+func NewScanner(r io.Reader) *Scanner {
+	return NewScannerWith(r, ScannerOptions{})
+}
+
+// NewScannerWith returns a Scanner reading r configured by opts.
+func NewScannerWith(r io.Reader, opts ScannerOptions) *Scanner {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	return &Scanner{sc: sc, opts: opts}
+}
+
+// Scan advances to the next version line, which is then available through
+// Version. It returns false at the end of the input, on a read error or
+// when an invalid line stops a ScanStop scanner; see Err.
+func (s *Scanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+
+	for s.sc.Scan() {
+		s.line++
+		text := strings.TrimSpace(s.sc.Text())
+		if text == "" && !s.opts.KeepBlank {
+			continue
+		}
+		if s.opts.CommentPrefix != "" && text != "" && strings.HasPrefix(text, s.opts.CommentPrefix) {
+			continue
+		}
+
+		v, ok := ParseWith(text, s.opts.Parse)
+		if ok {
+			s.v, s.verr = v, nil
+			return true
+		}
+
+		err := fmt.Errorf("%w: line %d: %q", ErrInvalidVersion, s.line, text)
+		switch s.opts.Invalid {
+		case ScanSkip:
+			continue
+		case ScanStop:
+			s.v, s.verr, s.err = Semver{}, nil, err
+			return false
+		}
+
+		s.v, s.verr = v, err
+		return true
+	}
+
+	s.v, s.verr = Semver{}, nil
+	s.err = s.sc.Err()
+	return false
+}
+
+// Version returns the version of the current line. For an invalid line
+// reported under ScanReport the version is invalid (Original holds the
+// trimmed line) and err wraps ErrInvalidVersion with the line number.
+func (s *Scanner) Version() (Semver, error) {
+	return s.v, s.verr
+}
+
+// Line returns the 1-based number of the current line in the input.
+func (s *Scanner) Line() int {
+	return s.line
+}
+
+// Err returns the first read error, or the invalid line error that stopped
+// a ScanStop scanner; nil at a clean end of input.
+func (s *Scanner) Err() error {
+	return s.err
+}
@@ -0,0 +1,56 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestScanner(t *testing.T) {
+	const input = "v1.0.0\n\n  # release candidates\n2.0.0-rc.1  \nbad\n3.0.0"
+
+	tests := []struct {
+		name    string
+		opts    ScannerOptions
+		want    string // "orig@line" per yielded line, "!" marks an error
+		wantErr bool
+	}{
+		{"default", ScannerOptions{}, `v1.0.0@1 # release candidates@3! 2.0.0-rc.1@4 bad@5! 3.0.0@6`, false},
+		{"comments", ScannerOptions{CommentPrefix: "#"}, `v1.0.0@1 2.0.0-rc.1@4 bad@5! 3.0.0@6`, false},
+		{"blank", ScannerOptions{CommentPrefix: "#", KeepBlank: true}, `v1.0.0@1 @2! 2.0.0-rc.1@4 bad@5! 3.0.0@6`, false},
+		{"skip", ScannerOptions{CommentPrefix: "#", Invalid: ScanSkip}, `v1.0.0@1 2.0.0-rc.1@4 3.0.0@6`, false},
+		{"stop", ScannerOptions{CommentPrefix: "#", Invalid: ScanStop}, `v1.0.0@1 2.0.0-rc.1@4`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := NewScannerWith(strings.NewReader(input), tt.opts)
+
+			var got []string
+			for sc.Scan() {
+				v, err := sc.Version()
+				item := fmt.Sprintf("%s@%d", v.Original, sc.Line())
+				if err != nil {
+					if !errors.Is(err, ErrInvalidVersion) || v.Valid {
+						t.Errorf("line %d: err = %v, valid = %v", sc.Line(), err, v.Valid)
+					}
+					item += "!"
+				}
+				got = append(got, item)
+			}
+
+			if s := strings.Join(got, " "); s != tt.want {
+				t.Errorf("got %q, want %q", s, tt.want)
+			}
+
+			err := sc.Err()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Err() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != `semver: invalid version: line 5: "bad"` {
+				t.Errorf("Err() = %q", err)
+			}
+		})
+	}
+}
@@ -16,6 +16,10 @@ type Semver struct {
 	// Zero-copy slice of Original when parsed; after mutators it may be a standalone string.
 	Build string
 
+	// Epoch optional distro-style epoch ("2:1.4.0"), only set by ParseWith
+	// with AllowEpoch. Dominates comparison; absent counts as 0.
+	Epoch int
+
 	// Major numeric component (normalized, no leading zeros)
 	Major int
 
@@ -25,6 +29,10 @@ type Semver struct {
 	// Patch numeric component (normalized, no leading zeros)
 	Patch int
 
+	// Revision optional fourth numeric component ("1.2.3.4"), only set by
+	// ParseWith with AllowRevision. Excluded from canonical output.
+	Revision int
+
 	// Flags auxiliary flags affecting parsing or comparison behavior.
 	Flags Flags
 
@@ -41,7 +49,27 @@ func (v Semver) IsValid() bool {
 // Returns -1 if v < w, 0 if v == w, +1 if v > w.
 // Build metadata is ignored. Release (no prerelease) has higher precedence
 // than any prerelease. Invalid versions are always smaller than valid ones.
+// Epoch and Revision (ParseWith only) are compared first and last; see
+// CompareWith to leave the revision out.
 func (v Semver) Compare(w Semver) int {
+	return v.compare(w, true)
+}
+
+// CompareOptions tunes Semver.CompareWith.
+// The zero value compares like Compare.
+type CompareOptions struct {
+	// IgnoreRevision drops the final Revision tie-break, so "1.2.3.4" and
+	// "1.2.3.5" compare equal like their Canonical forms.
+	IgnoreRevision bool
+}
+
+// CompareWith is like Compare with the behavior tuned by opts.
+func (v Semver) CompareWith(w Semver, opts CompareOptions) int {
+	return v.compare(w, !opts.IgnoreRevision)
+}
+
+// compare implements Compare, breaking ties on Revision if revision is set.
+func (v Semver) compare(w Semver, revision bool) int {
 	if !v.Valid && !w.Valid {
 		return 0
 	}
@@ -52,6 +80,14 @@ func (v Semver) Compare(w Semver) int {
 		return 1
 	}
 
+	// epoch dominates, absent counts as 0
+	if v.Epoch != w.Epoch {
+		if v.Epoch < w.Epoch {
+			return -1
+		}
+		return 1
+	}
+
 	// numeric core
 	if v.Major != w.Major {
 		if v.Major < w.Major {
@@ -79,15 +115,25 @@ func (v Semver) Compare(w Semver) int {
 	wHasPre := w.Flags&FlagHasPre != 0
 
 	switch {
-	case !vHasPre && !wHasPre:
-		return 0
 	case !vHasPre && wHasPre:
 		return 1
 	case vHasPre && !wHasPre:
 		return -1
-	default:
-		return comparePrerelease(v.Prerelease, w.Prerelease)
+	case vHasPre && wHasPre:
+		if r := comparePrerelease(v.Prerelease, w.Prerelease); r != 0 {
+			return r
+		}
 	}
+
+	// revision: final tie-break, absent counts as 0
+	if revision && v.Revision != w.Revision {
+		if v.Revision < w.Revision {
+			return -1
+		}
+		return 1
+	}
+
+	return 0
 }
 
 // Max returns the greater of two Semver values.
@@ -118,3 +164,25 @@ func (v Semver) IsLower(w Semver) bool {
 func (v Semver) IsEqual(w Semver) bool {
 	return v.Compare(w) == 0
 }
+
+// StrictEqual reports whether v and w are identical, including build
+// metadata and input formatting ("v" prefix, shorthand): "v1.2.3" and
+// "1.2.3" are not strictly equal.
+func (v Semver) StrictEqual(w Semver) bool {
+	return v == w
+}
+
+// EqualPrecedence reports whether v and w have equal SemVer precedence,
+// build metadata is ignored. It is the same as IsEqual.
+func (v Semver) EqualPrecedence(w Semver) bool {
+	return v.Compare(w) == 0
+}
+
+// EqualCore reports whether v and w have the same numeric core
+// (epoch, MAJOR.MINOR.PATCH and revision), prerelease and build are ignored.
+// Always false if either version is invalid.
+func (v Semver) EqualCore(w Semver) bool {
+	return v.Valid && w.Valid &&
+		v.Epoch == w.Epoch && v.Major == w.Major && v.Minor == w.Minor &&
+		v.Patch == w.Patch && v.Revision == w.Revision
+}
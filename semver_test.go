@@ -268,6 +268,10 @@ func BenchmarkCompare_PreRelease(b *testing.B) {
 		b.Run(tc.name, func(b *testing.B) {
 			v1, _ := Parse(tc.a)
 			v2, _ := Parse(tc.b)
+			if n := testing.AllocsPerRun(10, func() { sinkInt = v1.Compare(v2) }); n != 0 {
+				b.Fatalf("Compare(%q, %q) allocates %v times, want 0", tc.a, tc.b, n)
+			}
+
 			b.ReportAllocs()
 			b.ResetTimer()
 			sum := 0
@@ -280,7 +284,7 @@ func BenchmarkCompare_PreRelease(b *testing.B) {
 }
 
 // Benchmark the internal comparePrerelease() directly (isolated).
-// Note: current implementation allocates due to "-" + a trick.
+// comparePrerelease must not allocate.
 func BenchmarkCompare_PreRelease_Direct(b *testing.B) {
 	cases := []struct {
 		name string
@@ -296,6 +300,10 @@ func BenchmarkCompare_PreRelease_Direct(b *testing.B) {
 
 	for _, tc := range cases {
 		b.Run(tc.name, func(b *testing.B) {
+			if n := testing.AllocsPerRun(10, func() { sinkInt = comparePrerelease(tc.a, tc.b) }); n != 0 {
+				b.Fatalf("comparePrerelease(%q, %q) allocates %v times, want 0", tc.a, tc.b, n)
+			}
+
 			b.ReportAllocs()
 			b.ResetTimer()
 			sum := 0
@@ -375,3 +383,155 @@ func BenchmarkParseThenCanonical(b *testing.B) {
 		b.Log(n)
 	}
 }
+
+// TestMust checks MustParse, Must and MustConstraint panics.
+func TestMust(t *testing.T) {
+	if v := MustParse("1.2.3"); v.Canonical() != "v1.2.3" {
+		t.Fatalf("MustParse = %q", v.Canonical())
+	}
+	if v := Must(MustParse("1.2.3").WithPre("rc.1")); v.Canonical() != "v1.2.3-rc.1" {
+		t.Fatalf("Must(WithPre) = %q", v.Canonical())
+	}
+	if c := MustConstraint("^1.2"); !c.Check(MustParse("1.9.0")) {
+		t.Fatalf("MustConstraint(^1.2) rejected 1.9.0")
+	}
+
+	for name, fn := range map[string]func(){
+		"MustParse":      func() { MustParse("bad") },
+		"Must":           func() { Must(MustParse("1.2.3").WithPre("01")) },
+		"MustConstraint": func() { MustConstraint(">=") },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", name)
+				}
+			}()
+			fn()
+		}()
+	}
+}
+
+// TestParseWith_Revision covers the four-segment tolerance mode.
+func TestParseWith_Revision(t *testing.T) {
+	opts := ParseOptions{AllowRevision: true}
+
+	if _, ok := Parse("1.2.3.4"); ok {
+		t.Fatalf("Parse accepted a fourth component")
+	}
+	for _, in := range []string{"1.2.3.", "1.2.3.04", "1.2.3.4.5", "1.2.3.x"} {
+		if _, ok := ParseWith(in, opts); ok {
+			t.Errorf("ParseWith(%q) accepted invalid input", in)
+		}
+	}
+
+	v, ok := ParseWith("V10.0.19045.3693-rc.1+b", opts)
+	if !ok || v.Revision != 3693 || !v.HasRevision() || v.Patch != 19045 || v.Prerelease != "rc.1" {
+		t.Fatalf("ParseWith = %+v, %v", v, ok)
+	}
+	if got := v.Canonical(); got != "v10.0.19045-rc.1" {
+		t.Errorf("Canonical = %q", got)
+	}
+	if got := v.String(); got != "V10.0.19045.3693-rc.1+b" {
+		t.Errorf("String = %q", got)
+	}
+
+	// revision is the final tie-break, absent counts as 0
+	a, _ := ParseWith("1.2.3.4", opts)
+	b, _ := ParseWith("1.2.3.10", opts)
+	c, _ := ParseWith("1.2.3", opts)
+	if a.Compare(b) != -1 || c.Compare(a) != -1 || !c.IsEqual(MustParse("1.2.3")) {
+		t.Errorf("revision comparison mismatch")
+	}
+	if pre, _ := ParseWith("1.2.3.9-rc.1", opts); pre.Compare(c) != -1 {
+		t.Errorf("prerelease must sort before release regardless of revision")
+	}
+	ignore := CompareOptions{IgnoreRevision: true}
+	if a.CompareWith(b, ignore) != 0 || a.CompareWith(b, CompareOptions{}) != -1 {
+		t.Errorf("CompareWith(IgnoreRevision) mismatch")
+	}
+	if pre, _ := ParseWith("1.2.3.9-rc.1", opts); pre.CompareWith(c, ignore) != -1 {
+		t.Errorf("CompareWith(IgnoreRevision) must keep prerelease precedence")
+	}
+
+	if nv, _ := b.BumpPatch(); nv.Original != "1.2.4" || nv.HasRevision() {
+		t.Errorf("BumpPatch = %q, revision must be cleared", nv.Original)
+	}
+	if nv, _ := b.WithPre("rc.1"); nv.Original != "1.2.3.10-rc.1" {
+		t.Errorf("WithPre = %q, revision must be kept", nv.Original)
+	}
+}
+
+// TestParseWith_Epoch covers distro-style epochs.
+func TestParseWith_Epoch(t *testing.T) {
+	opts := ParseOptions{AllowEpoch: true}
+
+	if _, ok := Parse("2:1.4.0"); ok {
+		t.Fatalf("Parse accepted an epoch")
+	}
+	for _, in := range []string{"2:", "02:1.0.0", ":1.0.0", "1:2:3.0.0", "2:v"} {
+		if _, ok := ParseWith(in, opts); ok {
+			t.Errorf("ParseWith(%q) accepted invalid input", in)
+		}
+	}
+
+	v, ok := ParseWith("2:V1.4.0-rc.1+b", opts)
+	if !ok || v.Epoch != 2 || !v.HasEpoch() || !v.HasV() || v.Major != 1 || v.Prerelease != "rc.1" || v.Build != "b" {
+		t.Fatalf("ParseWith = %+v, %v", v, ok)
+	}
+	if got := v.String(); got != "2:V1.4.0-rc.1+b" {
+		t.Errorf("String = %q", got)
+	}
+	if got := v.Canonical(); got != "v1.4.0-rc.1" {
+		t.Errorf("Canonical = %q", got)
+	}
+
+	// epoch dominates, absent counts as 0
+	hi, _ := ParseWith("1:0.1.0", opts)
+	lo, _ := ParseWith("9.9.9", opts)
+	zero, _ := ParseWith("0:9.9.9", opts)
+	if hi.Compare(lo) != 1 || !zero.IsEqual(lo) {
+		t.Errorf("epoch comparison mismatch")
+	}
+
+	// both options at once
+	both, ok := ParseWith("3:10.0.1.7", ParseOptions{AllowEpoch: true, AllowRevision: true})
+	if !ok || both.Epoch != 3 || both.Revision != 7 || both.String() != "3:10.0.1.7" {
+		t.Errorf("ParseWith(both) = %+v, %v", both, ok)
+	}
+
+	if nv, _ := hi.BumpMinor(); nv.Original != "1:0.2.0" {
+		t.Errorf("BumpMinor = %q, epoch must be kept", nv.Original)
+	}
+}
+
+func TestEqualityLevels(t *testing.T) {
+	tests := []struct {
+		a, b                     string
+		strict, precedence, core bool
+	}{
+		{"1.2.3", "1.2.3", true, true, true},
+		{"v1.2.3", "1.2.3", false, true, true},
+		{"1.2", "1.2.0", false, true, true},
+		{"1.2.3+a", "1.2.3+b", false, true, true},
+		{"1.2.3-rc.1", "1.2.3", false, false, true},
+		{"1.2.3-rc.1+a", "1.2.3-rc.1+b", false, true, true},
+		{"1.2.3", "1.2.4", false, false, false},
+		{"bad", "bad", true, true, false},
+		{"bad", "1.2.3", false, false, false},
+	}
+
+	for _, tt := range tests {
+		a, _ := Parse(tt.a)
+		b, _ := Parse(tt.b)
+		if got := a.StrictEqual(b); got != tt.strict {
+			t.Errorf("%q.StrictEqual(%q) = %v, want %v", tt.a, tt.b, got, tt.strict)
+		}
+		if got := a.EqualPrecedence(b); got != tt.precedence {
+			t.Errorf("%q.EqualPrecedence(%q) = %v, want %v", tt.a, tt.b, got, tt.precedence)
+		}
+		if got := a.EqualCore(b); got != tt.core {
+			t.Errorf("%q.EqualCore(%q) = %v, want %v", tt.a, tt.b, got, tt.core)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Package semverpb provides the semver.v1.Version protobuf message
+(semver.proto) and conversions from and to semver.Semver.
+
+The Go type is hand-written and dependency free, so it is not a
+proto.Message: it can not be passed to proto.Marshal, used as a gRPC
+request or response, or embedded in generated messages. Marshal and
+Unmarshal implement the protobuf wire format of the message instead, so
+the bytes are interchangeable with code generated by protoc from
+semver.proto in any language. Services using generated code unmarshal
+these bytes into their generated type, or copy the fields directly.
+*/
+package semverpb
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/woozymasta/semver"
+)
+
+// ErrMalformed is returned by Unmarshal for invalid wire data.
+var ErrMalformed = errors.New("semverpb: malformed message")
+
+// field numbers of semver.v1.Version
+const (
+	fieldMajor      = 1
+	fieldMinor      = 2
+	fieldPatch      = 3
+	fieldPrerelease = 4
+	fieldBuild      = 5
+	fieldOriginal   = 6
+	fieldEpoch      = 7
+	fieldRevision   = 8
+)
+
+// wire types
+const (
+	wireVarint = 0
+	wireI64    = 1
+	wireBytes  = 2
+	wireI32    = 5
+)
+
+// Version mirrors the semver.v1.Version protobuf message.
+type Version struct {
+	Prerelease string
+	Build      string
+	Original   string
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Epoch      uint64
+	Revision   uint64
+}
+
+// parseOptions accepts the epoch and revision forms the message can carry.
+var parseOptions = semver.ParseOptions{AllowEpoch: true, AllowRevision: true}
+
+// ToProto converts v into a message, including the Epoch and Revision of
+// versions parsed with semver.ParseWith. Returns nil for invalid versions.
+func ToProto(v semver.Semver) *Version {
+	if !v.Valid {
+		return nil
+	}
+
+	return &Version{
+		Major:      uint64(v.Major),
+		Minor:      uint64(v.Minor),
+		Patch:      uint64(v.Patch),
+		Epoch:      uint64(v.Epoch),
+		Revision:   uint64(v.Revision),
+		Prerelease: v.Prerelease,
+		Build:      v.Build,
+		Original:   v.Original,
+	}
+}
+
+// FromProto converts a message into Semver, validating prerelease, build
+// and numeric ranges. A nonzero Epoch or Revision yields the version
+// semver.ParseWith accepts with AllowEpoch and AllowRevision. When Original
+// is set and describes the same version, its prefix and shorthand style are
+// kept. Returns (zero, false) for nil or invalid messages.
+func FromProto(m *Version) (semver.Semver, bool) {
+	if m == nil {
+		return semver.Semver{}, false
+	}
+
+	s := strconv.FormatUint(m.Major, 10) + "." +
+		strconv.FormatUint(m.Minor, 10) + "." +
+		strconv.FormatUint(m.Patch, 10)
+	if m.Epoch != 0 {
+		s = strconv.FormatUint(m.Epoch, 10) + ":" + s
+	}
+	if m.Revision != 0 {
+		s += "." + strconv.FormatUint(m.Revision, 10)
+	}
+	if m.Prerelease != "" {
+		s += "-" + m.Prerelease
+	}
+	if m.Build != "" {
+		s += "+" + m.Build
+	}
+
+	v, ok := semver.ParseWith(s, parseOptions)
+	if !ok {
+		return v, false
+	}
+
+	if m.Original != "" {
+		if o, ok := semver.ParseWith(m.Original, parseOptions); ok &&
+			o.Epoch == v.Epoch && o.Revision == v.Revision &&
+			o.Major == v.Major && o.Minor == v.Minor && o.Patch == v.Patch &&
+			o.Prerelease == v.Prerelease && o.Build == v.Build {
+			return o, true
+		}
+	}
+
+	return v, true
+}
+
+// Marshal encodes m in protobuf wire format. Zero fields are omitted (proto3).
+func (m *Version) Marshal() []byte {
+	b := make([]byte, 0, 32+len(m.Prerelease)+len(m.Build)+len(m.Original))
+	b = appendVarintField(b, fieldMajor, m.Major)
+	b = appendVarintField(b, fieldMinor, m.Minor)
+	b = appendVarintField(b, fieldPatch, m.Patch)
+	b = appendStringField(b, fieldPrerelease, m.Prerelease)
+	b = appendStringField(b, fieldBuild, m.Build)
+	b = appendStringField(b, fieldOriginal, m.Original)
+	b = appendVarintField(b, fieldEpoch, m.Epoch)
+	b = appendVarintField(b, fieldRevision, m.Revision)
+
+	return b
+}
+
+// Unmarshal decodes protobuf wire data into m. Unknown fields are skipped.
+func (m *Version) Unmarshal(data []byte) error {
+	var out Version
+	for len(data) > 0 {
+		key, n := uvarint(data)
+		if n <= 0 {
+			return ErrMalformed
+		}
+		data = data[n:]
+
+		field, wire := key>>3, key&7
+		switch wire {
+		case wireVarint:
+			x, n := uvarint(data)
+			if n <= 0 {
+				return ErrMalformed
+			}
+			data = data[n:]
+
+			switch field {
+			case fieldMajor:
+				out.Major = x
+			case fieldMinor:
+				out.Minor = x
+			case fieldPatch:
+				out.Patch = x
+			case fieldEpoch:
+				out.Epoch = x
+			case fieldRevision:
+				out.Revision = x
+			}
+
+		case wireBytes:
+			l, n := uvarint(data)
+			if n <= 0 || l > uint64(len(data)-n) {
+				return ErrMalformed
+			}
+			s := string(data[n : n+int(l)])
+			data = data[n+int(l):]
+
+			switch field {
+			case fieldPrerelease:
+				out.Prerelease = s
+			case fieldBuild:
+				out.Build = s
+			case fieldOriginal:
+				out.Original = s
+			}
+
+		case wireI64:
+			if len(data) < 8 {
+				return ErrMalformed
+			}
+			data = data[8:]
+
+		case wireI32:
+			if len(data) < 4 {
+				return ErrMalformed
+			}
+			data = data[4:]
+
+		default:
+			return ErrMalformed
+		}
+	}
+
+	*m = out
+	return nil
+}
+
+// appendVarintField appends a varint field unless x is zero.
+func appendVarintField(b []byte, field int, x uint64) []byte {
+	if x == 0 {
+		return b
+	}
+
+	b = appendUvarint(b, uint64(field)<<3|wireVarint)
+	return appendUvarint(b, x)
+}
+
+// appendStringField appends a length-delimited field unless s is empty.
+func appendStringField(b []byte, field int, s string) []byte {
+	if s == "" {
+		return b
+	}
+
+	b = appendUvarint(b, uint64(field)<<3|wireBytes)
+	b = appendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+// appendUvarint appends x in base 128 varint encoding.
+func appendUvarint(b []byte, x uint64) []byte {
+	for x >= 0x80 {
+		b = append(b, byte(x)|0x80)
+		x >>= 7
+	}
+
+	return append(b, byte(x))
+}
+
+// uvarint decodes a base 128 varint; n <= 0 reports malformed input.
+func uvarint(b []byte) (x uint64, n int) {
+	var s uint
+	for i := 0; i < len(b) && i < 10; i++ {
+		c := b[i]
+		if c < 0x80 {
+			if i == 9 && c > 1 {
+				return 0, -1
+			}
+			return x | uint64(c)<<s, i + 1
+		}
+		x |= uint64(c&0x7f) << s
+		s += 7
+	}
+
+	return 0, -1
+}
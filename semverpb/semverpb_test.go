@@ -0,0 +1,81 @@
+package semverpb
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/woozymasta/semver"
+)
+
+// TestRoundTrip checks Semver -> message -> wire -> message -> Semver.
+func TestRoundTrip(t *testing.T) {
+	for _, s := range []string{"V1.2.3-rc.1+meta", "1.2", "v0.0.0", "10.20.30+build.5", "2:1.2.3.4-rc.1", "v1.2.3.0", "1:0.1"} {
+		v, ok := semver.ParseWith(s, parseOptions)
+		if !ok {
+			t.Fatalf("ParseWith(%q) failed", s)
+		}
+
+		var m Version
+		if err := m.Unmarshal(ToProto(v).Marshal()); err != nil {
+			t.Fatalf("Unmarshal(%q): %v", s, err)
+		}
+
+		got, ok := FromProto(&m)
+		if !ok || got != v {
+			t.Errorf("round trip %q: got %+v ok=%v, want %+v", s, got, ok, v)
+		}
+	}
+}
+
+// TestWireFormat checks the encoding against hand-assembled protobuf bytes.
+func TestWireFormat(t *testing.T) {
+	m := &Version{Major: 1, Patch: 300, Prerelease: "rc"}
+	want := []byte{0x08, 0x01, 0x18, 0xac, 0x02, 0x22, 0x02, 'r', 'c'}
+	if got := m.Marshal(); !bytes.Equal(got, want) {
+		t.Fatalf("Marshal = %x, want %x", got, want)
+	}
+
+	// unknown fields of every wire type are skipped
+	data := append([]byte{
+		0x58, 0x05, // field 11 varint
+		0x61, 0, 0, 0, 0, 0, 0, 0, 0, // field 12 fixed64
+		0x6d, 0, 0, 0, 0, // field 13 fixed32
+		0x72, 0x01, 'x', // field 14 bytes
+	}, want...)
+
+	var got Version
+	if err := got.Unmarshal(data); err != nil || got != *m {
+		t.Fatalf("Unmarshal = %+v, %v; want %+v", got, err, *m)
+	}
+
+	for i := 1; i < len(want); i++ {
+		if err := got.Unmarshal(want[:i]); i != 2 && i != 5 && !errors.Is(err, ErrMalformed) {
+			t.Errorf("truncated to %d bytes: err = %v, want ErrMalformed", i, err)
+		}
+	}
+}
+
+// TestFromProto_Invalid checks validation of incoming messages.
+func TestFromProto_Invalid(t *testing.T) {
+	for _, m := range []*Version{
+		nil,
+		{Major: 1, Prerelease: "01"},
+		{Major: 1, Build: "a..b"},
+		{Major: 1 << 63},
+	} {
+		if _, ok := FromProto(m); ok {
+			t.Errorf("FromProto(%+v) accepted invalid message", m)
+		}
+	}
+
+	// mismatching Original is ignored
+	v, ok := FromProto(&Version{Major: 2, Original: "v1.0.0"})
+	if !ok || v.Original != "2.0.0" {
+		t.Errorf("FromProto with stale Original = %q", v.Original)
+	}
+
+	if ToProto(semver.Semver{}) != nil {
+		t.Errorf("ToProto(invalid) != nil")
+	}
+}
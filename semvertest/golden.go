@@ -0,0 +1,101 @@
+package semvertest
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// golden is the corpus shipped with the package, see Golden.
+//
+//go:embed golden.txt
+var golden string
+
+// GoldenCase is one expected parse result of a golden corpus.
+type GoldenCase struct {
+	// Input is the string to parse.
+	Input string
+
+	// Canonical is the expected Canonical() rendering of valid input.
+	Canonical string
+
+	// Valid reports whether Input must parse.
+	Valid bool
+}
+
+// Golden returns the golden corpus of this package: the semver.org examples
+// and the package's own extensions with their expected results.
+func Golden() []GoldenCase {
+	cases, err := LoadGolden(strings.NewReader(golden))
+	if err != nil {
+		panic(err)
+	}
+
+	return cases
+}
+
+// LoadGolden reads a golden corpus, one case per line:
+//
+//	# comment
+//	"1.2.3-rc.1+b"	valid	v1.2.3-rc.1
+//	"01.2.3"	invalid
+//
+// Fields are tab separated. The input is Go-quoted (a bare input without
+// tabs or surrounding spaces is accepted too); blank lines and lines
+// starting with '#' are skipped.
+func LoadGolden(r io.Reader) ([]GoldenCase, error) {
+	var cases []GoldenCase
+
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		in := fields[0]
+		if strings.HasPrefix(in, `"`) {
+			s, err := strconv.Unquote(in)
+			if err != nil {
+				return nil, fmt.Errorf("semvertest: golden line %d: bad input %s: %w", n, in, err)
+			}
+			in = s
+		}
+
+		c := GoldenCase{Input: in}
+		switch {
+		case len(fields) == 3 && fields[1] == "valid":
+			c.Valid, c.Canonical = true, fields[2]
+		case len(fields) == 2 && fields[1] == "invalid":
+		default:
+			return nil, fmt.Errorf("semvertest: golden line %d: want INPUT<TAB>valid<TAB>CANONICAL or INPUT<TAB>invalid", n)
+		}
+
+		cases = append(cases, c)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("semvertest: read golden corpus: %w", err)
+	}
+
+	return cases, nil
+}
+
+// CheckGolden parses every case and returns an error per mismatch.
+func CheckGolden(parse ParseFunc, cases []GoldenCase) []error {
+	var errs []error
+	for _, c := range cases {
+		v, ok := parse(c.Input)
+		switch {
+		case ok != c.Valid:
+			errs = append(errs, fmt.Errorf("semvertest: parse(%q) ok = %v, want %v", c.Input, ok, c.Valid))
+		case ok && v.Canonical() != c.Canonical:
+			errs = append(errs, fmt.Errorf("semvertest: parse(%q).Canonical() = %q, want %q", c.Input, v.Canonical(), c.Canonical))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,261 @@
+package semvertest
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Reference is another semver implementation to compare a parser against.
+// Valid is required, Canonical and Compare are compared when set.
+//
+// golang.org/x/mod/semver plugs in directly:
+//
+//	ref := semvertest.Reference{
+//		Name:      "x/mod",
+//		Valid:     modsemver.IsValid,
+//		Canonical: modsemver.Canonical,
+//		Compare:   modsemver.Compare,
+//	}
+//	for _, d := range semvertest.Diff(semver.Parse, ref, inputs, semvertest.XModAllowances) {
+//		if !d.Intentional() {
+//			t.Error(d)
+//		}
+//	}
+type Reference struct {
+	Valid     func(string) bool
+	Canonical func(string) string
+	Compare   func(a, b string) int
+	Name      string
+}
+
+// reSemVerOrg is the regular expression suggested by semver.org.
+var reSemVerOrg = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// SemVerOrg is the semver.org regular expression as a Reference, with
+// Canonical rendering "vMAJOR.MINOR.PATCH[-PRERELEASE]" from its groups.
+var SemVerOrg = Reference{
+	Name:  "semver.org",
+	Valid: reSemVerOrg.MatchString,
+	Canonical: func(s string) string {
+		m := reSemVerOrg.FindStringSubmatch(s)
+		if m == nil {
+			return ""
+		}
+		out := "v" + m[1] + "." + m[2] + "." + m[3]
+		if m[4] != "" {
+			out += "-" + m[4]
+		}
+		return out
+	},
+}
+
+// Deviation is one disagreement between a parser and a Reference.
+type Deviation struct {
+	// Reference is the Name of the reference implementation.
+	Reference string
+
+	// Kind is "valid", "canonical" or "compare".
+	Kind string
+
+	// Input is the disagreeing input; Other the second operand for "compare".
+	Input, Other string
+
+	// Ours and Theirs are the results of the parser and the reference.
+	Ours, Theirs string
+
+	// Reason explains an intentional deviation, empty for accidental ones.
+	Reason string
+}
+
+// Intentional reports whether an Allowance matched d.
+func (d Deviation) Intentional() bool {
+	return d.Reason != ""
+}
+
+// String renders d for test output.
+func (d Deviation) String() string {
+	in := strconv.Quote(d.Input)
+	if d.Kind == "compare" {
+		in += " vs " + strconv.Quote(d.Other)
+	}
+
+	s := fmt.Sprintf("%s %s %s: ours %s, theirs %s", d.Reference, d.Kind, in, d.Ours, d.Theirs)
+	if d.Reason != "" {
+		s += " (intentional: " + d.Reason + ")"
+	}
+
+	return s
+}
+
+// Allowance declares a class of deviations as intentional.
+type Allowance struct {
+	Match  func(ref Reference, d Deviation) bool
+	Reason string
+}
+
+// SemVerOrgAllowances are the intentional deviations of semver.Parse from
+// the semver.org grammar.
+var SemVerOrgAllowances = []Allowance{
+	{
+		Reason: "optional v/V prefix",
+		Match: func(ref Reference, d Deviation) bool {
+			return d.Kind == "valid" && d.Ours == "valid" && len(d.Input) > 1 &&
+				(d.Input[0] == 'v' || d.Input[0] == 'V') &&
+				(ref.Valid(d.Input[1:]) || isShorthand(d.Input[1:]))
+		},
+	},
+	{
+		Reason: "MAJOR and MAJOR.MINOR shorthands",
+		Match: func(_ Reference, d Deviation) bool {
+			return d.Kind == "valid" && d.Ours == "valid" && isShorthand(d.Input)
+		},
+	},
+	{
+		Reason: "numeric components must fit into int",
+		Match: func(_ Reference, d Deviation) bool {
+			return d.Kind == "valid" && d.Ours == "invalid" && overflowsInt(d.Input)
+		},
+	},
+}
+
+// XModAllowances are the intentional deviations of semver.Parse from
+// golang.org/x/mod/semver.
+var XModAllowances = []Allowance{
+	{
+		Reason: "'v' prefix is optional and may be uppercase",
+		Match: func(ref Reference, d Deviation) bool {
+			return d.Kind == "valid" && d.Ours == "valid" && !strings.HasPrefix(d.Input, "v") &&
+				ref.Valid("v"+strings.TrimPrefix(d.Input, "V"))
+		},
+	},
+	{
+		Reason: "numeric components must fit into int",
+		Match: func(_ Reference, d Deviation) bool {
+			return d.Kind == "valid" && d.Ours == "invalid" && overflowsInt(d.Input)
+		},
+	},
+	{
+		Reason: "Canonical drops +incompatible like any build metadata",
+		Match: func(_ Reference, d Deviation) bool {
+			return d.Kind == "canonical" && strings.HasSuffix(d.Input, "+incompatible")
+		},
+	},
+}
+
+// Diff runs parse and ref over inputs and returns every disagreement on
+// validity, Canonical rendering and (pairwise, for inputs both consider
+// valid) Compare sign. Deviations matched by an allowance carry its Reason.
+func Diff(parse ParseFunc, ref Reference, inputs []string, allow []Allowance) []Deviation {
+	var ds []Deviation
+	add := func(d Deviation) {
+		d.Reference = ref.Name
+		for _, a := range allow {
+			if a.Match(ref, d) {
+				d.Reason = a.Reason
+				break
+			}
+		}
+		ds = append(ds, d)
+	}
+
+	var both []int
+	for i, in := range inputs {
+		v, ok := parse(in)
+		theirs := ref.Valid(in)
+		if ok != theirs {
+			add(Deviation{Kind: "valid", Input: in, Ours: validity(ok), Theirs: validity(theirs)})
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		both = append(both, i)
+		if ref.Canonical != nil {
+			if ours, theirs := v.Canonical(), ref.Canonical(in); ours != theirs {
+				add(Deviation{Kind: "canonical", Input: in, Ours: strconv.Quote(ours), Theirs: strconv.Quote(theirs)})
+			}
+		}
+	}
+
+	if ref.Compare == nil {
+		return ds
+	}
+
+	for x, i := range both {
+		a, _ := parse(inputs[i])
+		for _, j := range both[x+1:] {
+			b, _ := parse(inputs[j])
+			if ours, theirs := a.Compare(b), sign(ref.Compare(inputs[i], inputs[j])); ours != theirs {
+				add(Deviation{
+					Kind: "compare", Input: inputs[i], Other: inputs[j],
+					Ours: strconv.Itoa(ours), Theirs: strconv.Itoa(theirs),
+				})
+			}
+		}
+	}
+
+	return ds
+}
+
+// Accidental returns the deviations no allowance matched.
+func Accidental(ds []Deviation) []Deviation {
+	var out []Deviation
+	for _, d := range ds {
+		if !d.Intentional() {
+			out = append(out, d)
+		}
+	}
+
+	return out
+}
+
+// reShorthand matches the MAJOR and MAJOR.MINOR shorthands.
+var reShorthand = regexp.MustCompile(`^[vV]?(0|[1-9]\d*)(?:\.(0|[1-9]\d*))?$`)
+
+// isShorthand reports whether s is a MAJOR or MAJOR.MINOR shorthand.
+func isShorthand(s string) bool {
+	return reShorthand.MatchString(s)
+}
+
+// overflowsInt reports whether a MAJOR.MINOR.PATCH component of s does not
+// fit into int.
+func overflowsInt(s string) bool {
+	s = strings.TrimLeft(s, "vV")
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		s = s[:i]
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if _, err := strconv.Atoi(part); err != nil && strings.Trim(part, "0123456789") == "" && part != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validity renders a parse result for Deviation.
+func validity(ok bool) string {
+	if ok {
+		return "valid"
+	}
+
+	return "invalid"
+}
+
+// sign clamps a comparison result to -1, 0 or +1.
+func sign(r int) int {
+	switch {
+	case r < 0:
+		return -1
+	case r > 0:
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,125 @@
+package semvertest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/woozymasta/semver"
+)
+
+func TestGolden(t *testing.T) {
+	cases := Golden()
+	if len(cases) < 100 {
+		t.Fatalf("Golden() has %d cases", len(cases))
+	}
+
+	for _, err := range CheckGolden(semver.Parse, cases) {
+		t.Error(err)
+	}
+}
+
+func TestLoadGolden(t *testing.T) {
+	cases, err := LoadGolden(strings.NewReader("# c\n\n\"\"\tinvalid\n1.2\tvalid\tv1.2.0\n"))
+	if err != nil || len(cases) != 2 || cases[0].Input != "" || !cases[1].Valid || cases[1].Canonical != "v1.2.0" {
+		t.Fatalf("LoadGolden = %+v, %v", cases, err)
+	}
+
+	for _, in := range []string{"1.2\tvalid\n", "1.2\tmaybe\n", "\"1.2\tinvalid\n"} {
+		if _, err := LoadGolden(strings.NewReader(in)); err == nil {
+			t.Errorf("LoadGolden(%q) accepted malformed input", in)
+		}
+	}
+
+	// a failing expectation is reported
+	if errs := CheckGolden(semver.Parse, []GoldenCase{{Input: "1.2", Valid: true, Canonical: "v1.2.1"}}); len(errs) != 1 {
+		t.Errorf("CheckGolden errors = %v, want one", errs)
+	}
+}
+
+func TestDiff_SemVerOrg(t *testing.T) {
+	var inputs []string
+	for _, c := range Golden() {
+		inputs = append(inputs, c.Input)
+	}
+
+	ds := Diff(semver.Parse, SemVerOrg, inputs, SemVerOrgAllowances)
+	for _, d := range Accidental(ds) {
+		t.Error(d)
+	}
+
+	reasons := map[string]bool{}
+	for _, d := range ds {
+		reasons[d.Reason] = true
+	}
+	for _, a := range SemVerOrgAllowances {
+		if !reasons[a.Reason] {
+			t.Errorf("no deviation for allowance %q", a.Reason)
+		}
+	}
+
+	// a parser accepting garbage deviates accidentally
+	lax := func(s string) (semver.Semver, bool) {
+		if s == "1.2.3.DEV" {
+			return semver.MustParse("1.2.3"), true
+		}
+		return semver.Parse(s)
+	}
+	if acc := Accidental(Diff(lax, SemVerOrg, inputs, SemVerOrgAllowances)); len(acc) != 1 || acc[0].Input != "1.2.3.DEV" {
+		t.Errorf("Accidental = %v, want the 1.2.3.DEV deviation", acc)
+	}
+}
+
+// TestDiff_Compare checks pairwise order differences with a fake reference
+// that ignores prereleases.
+func TestDiff_Compare(t *testing.T) {
+	ref := Reference{
+		Name:  "fake",
+		Valid: func(s string) bool { _, ok := semver.Parse(s); return ok },
+		Compare: func(a, b string) int {
+			va, vb := semver.MustParse(a), semver.MustParse(b)
+			va, _ = va.WithPre("")
+			vb, _ = vb.WithPre("")
+			return va.Compare(vb) * 7
+		},
+	}
+
+	ds := Diff(semver.Parse, ref, []string{"1.0.0-rc.1", "1.0.0", "2.0.0"}, nil)
+	if len(ds) != 1 || ds[0].Kind != "compare" || ds[0].Ours != "-1" || ds[0].Theirs != "0" {
+		t.Fatalf("Diff = %v", ds)
+	}
+	if got := ds[0].String(); got != `fake compare "1.0.0-rc.1" vs "1.0.0": ours -1, theirs 0` {
+		t.Errorf("String = %q", got)
+	}
+}
+
+// TestXModAllowances runs the allowances against a reference following
+// golang.org/x/mod/semver validity rules (lowercase 'v' required, any size
+// numbers).
+func TestXModAllowances(t *testing.T) {
+	ref := Reference{
+		Name: "x/mod-like",
+		Valid: func(s string) bool {
+			if !strings.HasPrefix(s, "v") {
+				return false
+			}
+			_, ok := semver.Parse(s)
+			return ok || overflowsInt(s) && SemVerOrg.Valid(s[1:])
+		},
+		Canonical: func(s string) string {
+			v := semver.MustParse(s)
+			if strings.HasSuffix(s, "+incompatible") {
+				return v.Canonical() + "+incompatible"
+			}
+			return v.Canonical()
+		},
+	}
+
+	inputs := []string{"1.2.3", "V1.2.3", "v1.2.3", "v99999999999999999999.0.0", "v2.0.0+incompatible"}
+	ds := Diff(semver.Parse, ref, inputs, XModAllowances)
+	if len(ds) != 4 {
+		t.Errorf("Diff = %v, want 4 deviations", ds)
+	}
+	for _, d := range Accidental(ds) {
+		t.Error(d)
+	}
+}
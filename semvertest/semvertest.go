@@ -0,0 +1,210 @@
+/*
+Package semvertest checks the laws every semver parser and ordering in this
+module must preserve, for use in tests of dialect options, wrappers and forks.
+
+The checkers return a descriptive error for the first violated law:
+
+	CheckRoundTrip  Parse -> Print -> Parse and MarshalBinary round trips
+	CheckOrder      Compare reflexivity, antisymmetry and transitivity
+	CheckSort       List.Sort ordering and idempotence
+
+FuzzRoundTrip and FuzzOrder wrap them into native Go fuzz targets:
+
+	func FuzzParse(f *testing.F) {
+		semvertest.FuzzRoundTrip(f, myParse)
+	}
+
+Golden and LoadGolden provide expected parse results to check with
+CheckGolden. Diff compares a parser against a Reference implementation
+(the semver.org regular expression, golang.org/x/mod/semver) and separates
+intentional deviations, declared as Allowances, from accidental ones.
+*/
+package semvertest
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/woozymasta/semver"
+)
+
+// ParseFunc parses a version string, semver.Parse or a ParseWith closure.
+type ParseFunc func(string) (semver.Semver, bool)
+
+// Corpus is the seed corpus of the fuzz targets: valid versions of every
+// supported shape and near misses.
+var Corpus = []string{
+	"0.0.0", "1.2.3", "v1.2.3", "V1.2.3", "1", "v1.2", "10.20.30",
+	"1.2.3-rc.1", "1.2.3-alpha", "1.2.3-0", "1.2.3-alpha.beta.1",
+	"1.2.3+build.5", "v1.2.3-rc.1+build.5", "1.0.0-x-y-z.--",
+	"1.2.3.4", "2:1.4.0", "1:v2.0.0-rc.1+b",
+	"", "v", "01.2.3", "1.2.3-01", "1.2-rc.1", "1.2.3+", "1.2.3-", "1..3",
+	"99999999999999999999.0.0",
+}
+
+// CheckRoundTrip parses s and, if it is valid, verifies that rendering and
+// re-parsing preserve it:
+//
+//   - parse(v.String()) is valid, of equal precedence, with equal build
+//     metadata and renders to the same string;
+//   - parse(v.Canonical()) has equal precedence (without epoch and revision);
+//   - UnmarshalBinary(MarshalBinary(v)) has equal precedence, build metadata
+//     and rendering.
+//
+// Invalid input only has to be rejected consistently.
+func CheckRoundTrip(parse ParseFunc, s string) error {
+	v, ok := parse(s)
+	if ok != v.Valid {
+		return fmt.Errorf("semvertest: parse(%q) returned ok=%v for Valid=%v", s, ok, v.Valid)
+	}
+	if w, wok := parse(s); w != v || wok != ok {
+		return fmt.Errorf("semvertest: parse(%q) is not deterministic", s)
+	}
+	if !ok {
+		return nil
+	}
+
+	str := v.String()
+	w, ok := parse(str)
+	switch {
+	case !ok:
+		return fmt.Errorf("semvertest: parse(%q) rejects String() %q", s, str)
+	case w.Compare(v) != 0:
+		return fmt.Errorf("semvertest: parse(%q) = %q, String() %q re-parses with different precedence", s, v.Original, str)
+	case w.Build != v.Build:
+		return fmt.Errorf("semvertest: parse(%q) build %q, String() %q re-parses with build %q", s, v.Build, str, w.Build)
+	case w.String() != str:
+		return fmt.Errorf("semvertest: String() of %q is not idempotent: %q then %q", s, str, w.String())
+	}
+
+	if !v.HasEpoch() && !v.HasRevision() {
+		canon := v.Canonical()
+		if w, ok := parse(canon); !ok || w.Compare(v) != 0 {
+			return fmt.Errorf("semvertest: parse(%q): Canonical() %q does not re-parse to equal precedence", s, canon)
+		}
+	}
+
+	data, err := v.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("semvertest: MarshalBinary(%q): %w", s, err)
+	}
+	var b semver.Semver
+	if err := b.UnmarshalBinary(data); err != nil {
+		return fmt.Errorf("semvertest: UnmarshalBinary(MarshalBinary(%q)): %w", s, err)
+	}
+	if b.Compare(v) != 0 || b.Build != v.Build || b.String() != str {
+		return fmt.Errorf("semvertest: binary round trip of %q yields %q", s, b.String())
+	}
+
+	return nil
+}
+
+// CheckOrder verifies the ordering laws of Compare on a, b and c:
+// reflexivity, antisymmetry, transitivity, and agreement of IsEqual,
+// IsGreater, IsLower and Key equality with Compare.
+func CheckOrder(a, b, c semver.Semver) error {
+	vs := [3]semver.Semver{a, b, c}
+
+	for _, x := range vs {
+		if r := x.Compare(x); r != 0 {
+			return fmt.Errorf("semvertest: %q.Compare(itself) = %d", x.Original, r)
+		}
+
+		for _, y := range vs {
+			r := x.Compare(y)
+			if r < -1 || r > 1 {
+				return fmt.Errorf("semvertest: %q.Compare(%q) = %d, want -1, 0 or +1", x.Original, y.Original, r)
+			}
+			if y.Compare(x) != -r {
+				return fmt.Errorf("semvertest: Compare of %q and %q is not antisymmetric", x.Original, y.Original)
+			}
+			if x.IsEqual(y) != (r == 0) || x.IsGreater(y) != (r > 0) || x.IsLower(y) != (r < 0) {
+				return fmt.Errorf("semvertest: IsEqual/IsGreater/IsLower of %q and %q disagree with Compare", x.Original, y.Original)
+			}
+			if (x.Key() == y.Key()) != (r == 0) {
+				return fmt.Errorf("semvertest: Key equality of %q and %q disagrees with Compare", x.Original, y.Original)
+			}
+
+			for _, z := range vs {
+				if r <= 0 && y.Compare(z) <= 0 && x.Compare(z) > 0 {
+					return fmt.Errorf("semvertest: Compare is not transitive: %q <= %q <= %q but %q > %q",
+						x.Original, y.Original, z.Original, x.Original, z.Original)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckSort verifies that List.Sort orders ls by semver.CompareForSort,
+// keeps its elements and is idempotent. ls itself is not modified.
+func CheckSort(ls semver.List) error {
+	sorted := slices.Clone(ls)
+	sorted.Sort()
+
+	if !slices.IsSortedFunc(sorted, semver.CompareForSort) {
+		return fmt.Errorf("semvertest: Sort result is not ordered: %v", originals(sorted))
+	}
+
+	again := slices.Clone(sorted)
+	again.Sort()
+	if !slices.Equal(again, sorted) {
+		return fmt.Errorf("semvertest: Sort is not idempotent: %v then %v", originals(sorted), originals(again))
+	}
+
+	want := originals(ls)
+	got := originals(sorted)
+	slices.Sort(want)
+	slices.Sort(got)
+	if !slices.Equal(want, got) {
+		return fmt.Errorf("semvertest: Sort changed the elements: %v -> %v", originals(ls), originals(sorted))
+	}
+
+	return nil
+}
+
+// FuzzRoundTrip runs CheckRoundTrip on fuzzed input seeded with Corpus.
+func FuzzRoundTrip(f *testing.F, parse ParseFunc) {
+	for _, s := range Corpus {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		if err := CheckRoundTrip(parse, s); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// FuzzOrder runs CheckOrder and CheckSort on triples of fuzzed input
+// seeded from Corpus.
+func FuzzOrder(f *testing.F, parse ParseFunc) {
+	for i := range Corpus {
+		f.Add(Corpus[i], Corpus[(i+1)%len(Corpus)], Corpus[(i+7)%len(Corpus)])
+	}
+
+	f.Fuzz(func(t *testing.T, a, b, c string) {
+		va, _ := parse(a)
+		vb, _ := parse(b)
+		vc, _ := parse(c)
+
+		if err := CheckOrder(va, vb, vc); err != nil {
+			t.Fatal(err)
+		}
+		if err := CheckSort(semver.List{va, vb, vc}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// originals returns the Original of every element.
+func originals(ls semver.List) []string {
+	out := make([]string, len(ls))
+	for i, v := range ls {
+		out[i] = v.Original
+	}
+
+	return out
+}
@@ -0,0 +1,72 @@
+package semvertest
+
+import (
+	"testing"
+
+	"github.com/woozymasta/semver"
+)
+
+// parseDialect parses with every non-SemVer form enabled.
+func parseDialect(s string) (semver.Semver, bool) {
+	return semver.ParseWith(s, semver.ParseOptions{AllowRevision: true, AllowEpoch: true})
+}
+
+func FuzzParse(f *testing.F) {
+	FuzzRoundTrip(f, semver.Parse)
+}
+
+func FuzzParseDialect(f *testing.F) {
+	FuzzRoundTrip(f, parseDialect)
+}
+
+func FuzzCompare(f *testing.F) {
+	FuzzOrder(f, semver.Parse)
+}
+
+func FuzzCompareDialect(f *testing.F) {
+	FuzzOrder(f, parseDialect)
+}
+
+func TestCheckers_Corpus(t *testing.T) {
+	var ls semver.List
+	for _, s := range Corpus {
+		for _, parse := range []ParseFunc{semver.Parse, parseDialect} {
+			if err := CheckRoundTrip(parse, s); err != nil {
+				t.Error(err)
+			}
+			v, _ := parse(s)
+			ls = append(ls, v)
+		}
+	}
+
+	for i := range ls {
+		if err := CheckOrder(ls[i], ls[(i+1)%len(ls)], ls[(i+5)%len(ls)]); err != nil {
+			t.Error(err)
+		}
+	}
+	if err := CheckSort(ls); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestCheckers_Violations checks that broken parsers and orderings are reported.
+func TestCheckers_Violations(t *testing.T) {
+	// does not parse what it prints
+	drift := func(s string) (semver.Semver, bool) {
+		v, ok := semver.Parse(s)
+		v.Patch++
+		return v, ok
+	}
+	if err := CheckRoundTrip(drift, "1.2.3"); err == nil {
+		t.Error("CheckRoundTrip accepted a parser not round-tripping String")
+	}
+
+	// claims success for invalid input
+	liar := func(s string) (semver.Semver, bool) {
+		v, _ := semver.Parse(s)
+		return v, true
+	}
+	if err := CheckRoundTrip(liar, "bad"); err == nil {
+		t.Error("CheckRoundTrip accepted ok=true for an invalid version")
+	}
+}
@@ -0,0 +1,130 @@
+package semver
+
+// Set is an unordered collection of versions keyed by Key: versions of
+// equal precedence (differing only in build metadata, prefix or shorthand)
+// are the same member, the first one added is kept.
+// Invalid versions are never members. The zero value is an empty set,
+// and so is a nil *Set for every method but Add.
+type Set struct {
+	m map[Key]Semver
+}
+
+// members returns the members of s, nil for a nil set.
+func (s *Set) members() map[Key]Semver {
+	if s == nil {
+		return nil
+	}
+
+	return s.m
+}
+
+// NewSet returns a set holding the valid versions of vs;
+// NewSet(ls...) converts a List.
+func NewSet(vs ...Semver) *Set {
+	s := &Set{m: make(map[Key]Semver, len(vs))}
+	for _, v := range vs {
+		s.Add(v)
+	}
+
+	return s
+}
+
+// Add inserts v and reports whether it was not a member yet.
+func (s *Set) Add(v Semver) bool {
+	if !v.Valid {
+		return false
+	}
+
+	key := v.Key()
+	if _, ok := s.m[key]; ok {
+		return false
+	}
+	if s.m == nil {
+		s.m = make(map[Key]Semver)
+	}
+	s.m[key] = v
+
+	return true
+}
+
+// Remove deletes v and reports whether it was a member.
+func (s *Set) Remove(v Semver) bool {
+	if !v.Valid {
+		return false
+	}
+
+	key := v.Key()
+	if _, ok := s.members()[key]; !ok {
+		return false
+	}
+	delete(s.m, key)
+
+	return true
+}
+
+// Contains reports whether a version of equal precedence to v is a member.
+func (s *Set) Contains(v Semver) bool {
+	if !v.Valid {
+		return false
+	}
+
+	_, ok := s.members()[v.Key()]
+	return ok
+}
+
+// Len returns the number of members.
+func (s *Set) Len() int {
+	return len(s.members())
+}
+
+// Union returns a new set with the members of s and o;
+// members of s win over equal members of o.
+func (s *Set) Union(o *Set) *Set {
+	out := &Set{m: make(map[Key]Semver, s.Len()+o.Len())}
+	for k, v := range s.members() {
+		out.m[k] = v
+	}
+	for k, v := range o.members() {
+		if _, ok := out.m[k]; !ok {
+			out.m[k] = v
+		}
+	}
+
+	return out
+}
+
+// Intersect returns a new set with the members of s that are also in o.
+func (s *Set) Intersect(o *Set) *Set {
+	out := &Set{m: make(map[Key]Semver)}
+	for k, v := range s.members() {
+		if _, ok := o.members()[k]; ok {
+			out.m[k] = v
+		}
+	}
+
+	return out
+}
+
+// Difference returns a new set with the members of s that are not in o,
+// e.g. the tags published since a previous registry snapshot.
+func (s *Set) Difference(o *Set) *Set {
+	out := &Set{m: make(map[Key]Semver)}
+	for k, v := range s.members() {
+		if _, ok := o.members()[k]; !ok {
+			out.m[k] = v
+		}
+	}
+
+	return out
+}
+
+// List returns the members in ascending semver order.
+func (s *Set) List() List {
+	out := make(List, 0, s.Len())
+	for _, v := range s.members() {
+		out = append(out, v)
+	}
+	out.Sort()
+
+	return out
+}
@@ -0,0 +1,56 @@
+package semver
+
+import "testing"
+
+func TestSet(t *testing.T) {
+	var s Set
+	if !s.Add(MustParse("1.2.0")) || s.Add(MustParse("v1.2.0+build")) || s.Add(Semver{}) {
+		t.Fatalf("Add dedup by canonical form failed")
+	}
+	if !s.Contains(MustParse("1.2.0+other")) || s.Contains(MustParse("1.2.0-rc.1")) {
+		t.Fatalf("Contains mismatch")
+	}
+	if s.Len() != 1 || s.List()[0].Original != "1.2.0" {
+		t.Fatalf("first added member must be kept, got %v", s.List())
+	}
+	if !s.Remove(MustParse("v1.2.0")) || s.Remove(MustParse("1.2.0")) || s.Len() != 0 {
+		t.Fatalf("Remove failed")
+	}
+}
+
+func TestSet_Algebra(t *testing.T) {
+	yesterday := NewSet(mustList("1.0.0", "1.1.0", "2.0.0-rc.1", "bad")...)
+	today := NewSet(mustList("1.0.0", "1.1.0+rebuild", "2.0.0", "1.1.1")...)
+
+	tests := []struct {
+		name string
+		got  *Set
+		want []string
+	}{
+		{"union", yesterday.Union(today), []string{"1.0.0", "1.1.0", "1.1.1", "2.0.0-rc.1", "2.0.0"}},
+		{"intersect", today.Intersect(yesterday), []string{"1.0.0", "1.1.0+rebuild"}},
+		{"difference", today.Difference(yesterday), []string{"1.1.1", "2.0.0"}},
+		{"union nil", today.Union(nil), []string{"1.0.0", "1.1.0+rebuild", "1.1.1", "2.0.0"}},
+		{"nil union", (*Set)(nil).Union(today), []string{"1.0.0", "1.1.0+rebuild", "1.1.1", "2.0.0"}},
+		{"intersect nil", today.Intersect(nil), nil},
+		{"difference nil", today.Difference(nil), []string{"1.0.0", "1.1.0+rebuild", "1.1.1", "2.0.0"}},
+		{"nil difference", (*Set)(nil).Difference(today), nil},
+		{"zero union nil", new(Set).Union(nil), nil},
+	}
+
+	for _, tt := range tests {
+		ls := tt.got.List()
+		if len(ls) != len(tt.want) {
+			t.Fatalf("%s = %v, want %v", tt.name, ls, tt.want)
+		}
+		for i := range ls {
+			if ls[i].Original != tt.want[i] {
+				t.Fatalf("%s = %v, want %v", tt.name, ls, tt.want)
+			}
+		}
+	}
+	var nilSet *Set
+	if nilSet.Len() != 0 || nilSet.Contains(MustParse("1.0.0")) || nilSet.Remove(MustParse("1.0.0")) || len(nilSet.List()) != 0 {
+		t.Error("nil set is not empty")
+	}
+}
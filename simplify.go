@@ -0,0 +1,280 @@
+package semver
+
+import (
+	"slices"
+	"strings"
+)
+
+// constraintNone is the rendering of a constraint no version satisfies.
+const constraintNone = "<0.0.0-0"
+
+// bound is one end of an interval; unset means unbounded.
+type bound struct {
+	v    Semver
+	set  bool
+	incl bool
+}
+
+// interval is a contiguous range of versions between lo and hi.
+type interval struct {
+	lo, hi bound
+}
+
+// Simplify returns an equivalent constraint in minimal canonical form:
+// every "||" alternative is reduced to an interval, overlapping and adjacent
+// intervals are merged and the result is rendered with primitive comparators
+// in ascending order:
+//
+//	">=1.2.0 <1.3.0 || ~1.2.5 || 1.3.x"  ->  ">=1.2.0 <1.4.0-0"
+//	"^1.2 !=1.4.0"                       ->  ">=1.2.0 <1.4.0 || >1.4.0 <2.0.0-0"
+//
+// A constraint no version satisfies renders as "<0.0.0-0", one any valid
+// version satisfies as "*". Simplify is meant for display and aggregation of
+// requirements from many sources; String of the result is accepted by
+// ParseConstraint.
+//
+// Merging alternatives may drop the prerelease bounds the npm prerelease
+// rule of Check looks at, equivalence is only guaranteed for CheckWith with
+// IncludePrerelease.
+func (c Constraint) Simplify() Constraint {
+	return intervalConstraint(c.intervals())
+}
+
+// intervals returns c as disjoint intervals in ascending order.
+func (c Constraint) intervals() []interval {
+	var ivs []interval
+	for _, group := range c.groups {
+		ivs = append(ivs, groupIntervals(group)...)
+	}
+
+	return mergeIntervals(ivs)
+}
+
+// intervalConstraint renders disjoint ascending intervals as a Constraint.
+func intervalConstraint(ivs []interval) Constraint {
+	parts := make([]string, 0, len(ivs))
+	for _, iv := range ivs {
+		parts = append(parts, iv.String())
+	}
+
+	s := constraintNone
+	if len(parts) > 0 {
+		s = strings.Join(parts, " || ")
+	}
+
+	return MustConstraint(s)
+}
+
+// groupIntervals reduces AND-ed comparators to disjoint intervals in
+// ascending order; "!=" comparators split the range. Returns nil if the
+// group is unsatisfiable.
+func groupIntervals(group []comparator) []interval {
+	var iv interval
+	var holes []Semver
+	for _, cmp := range group {
+		switch cmp.op {
+		case opEQ:
+			iv.lo = tightenLo(iv.lo, cmp.v, true)
+			iv.hi = tightenHi(iv.hi, cmp.v, true)
+		case opGT:
+			iv.lo = tightenLo(iv.lo, cmp.v, false)
+		case opGE:
+			iv.lo = tightenLo(iv.lo, cmp.v, true)
+		case opLT:
+			iv.hi = tightenHi(iv.hi, cmp.v, false)
+		case opLE:
+			iv.hi = tightenHi(iv.hi, cmp.v, true)
+		case opNE:
+			holes = append(holes, cmp.v)
+		}
+	}
+	if iv.empty() {
+		return nil
+	}
+
+	ivs := []interval{iv}
+	slices.SortFunc(holes, Compare)
+	for _, h := range holes {
+		last := ivs[len(ivs)-1]
+		if !last.contains(h) {
+			continue
+		}
+
+		left := interval{lo: last.lo, hi: bound{v: h, set: true}}
+		right := interval{lo: bound{v: h, set: true}, hi: last.hi}
+		ivs = ivs[:len(ivs)-1]
+		if !left.empty() {
+			ivs = append(ivs, left)
+		}
+		if !right.empty() {
+			ivs = append(ivs, right)
+		}
+		if len(ivs) == 0 {
+			return nil
+		}
+	}
+
+	return ivs
+}
+
+// mergeIntervals sorts ivs by lower bound and merges overlapping or
+// adjacent intervals.
+func mergeIntervals(ivs []interval) []interval {
+	if len(ivs) == 0 {
+		return nil
+	}
+
+	slices.SortFunc(ivs, func(a, b interval) int {
+		return compareLo(a.lo, b.lo)
+	})
+
+	out := []interval{ivs[0]}
+	for _, iv := range ivs[1:] {
+		cur := &out[len(out)-1]
+		if !cur.touches(iv.lo) {
+			out = append(out, iv)
+			continue
+		}
+		if compareHi(iv.hi, cur.hi) > 0 {
+			cur.hi = iv.hi
+		}
+	}
+
+	return out
+}
+
+// tightenLo returns the higher of lower bounds b and (v, incl).
+func tightenLo(b bound, v Semver, incl bool) bound {
+	if nb := (bound{v: v, set: true, incl: incl}); compareLo(nb, b) > 0 {
+		return nb
+	}
+
+	return b
+}
+
+// tightenHi returns the lower of upper bounds b and (v, incl).
+func tightenHi(b bound, v Semver, incl bool) bound {
+	if nb := (bound{v: v, set: true, incl: incl}); compareHi(nb, b) < 0 {
+		return nb
+	}
+
+	return b
+}
+
+// compareLo orders lower bounds: unbounded first, inclusive before
+// exclusive at the same version.
+func compareLo(a, b bound) int {
+	switch {
+	case !a.set && !b.set:
+		return 0
+	case !a.set:
+		return -1
+	case !b.set:
+		return 1
+	}
+	if r := a.v.Compare(b.v); r != 0 {
+		return r
+	}
+
+	switch {
+	case a.incl == b.incl:
+		return 0
+	case a.incl:
+		return -1
+	}
+
+	return 1
+}
+
+// compareHi orders upper bounds: unbounded last, exclusive before
+// inclusive at the same version.
+func compareHi(a, b bound) int {
+	switch {
+	case !a.set && !b.set:
+		return 0
+	case !a.set:
+		return 1
+	case !b.set:
+		return -1
+	}
+	if r := a.v.Compare(b.v); r != 0 {
+		return r
+	}
+
+	switch {
+	case a.incl == b.incl:
+		return 0
+	case a.incl:
+		return 1
+	}
+
+	return -1
+}
+
+// empty reports whether no version lies within iv.
+func (iv interval) empty() bool {
+	if !iv.lo.set || !iv.hi.set {
+		return false
+	}
+
+	r := iv.lo.v.Compare(iv.hi.v)
+	return r > 0 || r == 0 && !(iv.lo.incl && iv.hi.incl)
+}
+
+// contains reports whether v lies within iv.
+func (iv interval) contains(v Semver) bool {
+	if iv.lo.set {
+		if r := v.Compare(iv.lo.v); r < 0 || r == 0 && !iv.lo.incl {
+			return false
+		}
+	}
+	if iv.hi.set {
+		if r := v.Compare(iv.hi.v); r > 0 || r == 0 && !iv.hi.incl {
+			return false
+		}
+	}
+
+	return true
+}
+
+// touches reports whether an interval starting at lo overlaps or is
+// adjacent to iv, given lo is not below iv's lower bound.
+func (iv interval) touches(lo bound) bool {
+	if !iv.hi.set || !lo.set {
+		return true
+	}
+
+	r := lo.v.Compare(iv.hi.v)
+	return r < 0 || r == 0 && (lo.incl || iv.hi.incl)
+}
+
+// String renders iv with primitive comparators ("*", "1.2.3",
+// ">=1.2.0 <2.0.0-0").
+func (iv interval) String() string {
+	const mask = PrintPrefixNoV | PrintMaskRelease | PrintPrerelease
+
+	switch {
+	case !iv.lo.set && !iv.hi.set:
+		return "*"
+	case iv.lo.set && iv.hi.set && iv.lo.incl && iv.hi.incl && iv.lo.v.Compare(iv.hi.v) == 0:
+		return iv.lo.v.Print(mask)
+	}
+
+	var parts []string
+	if iv.lo.set {
+		op := opGT
+		if iv.lo.incl {
+			op = opGE
+		}
+		parts = append(parts, op.String()+iv.lo.v.Print(mask))
+	}
+	if iv.hi.set {
+		op := opLT
+		if iv.hi.incl {
+			op = opLE
+		}
+		parts = append(parts, op.String()+iv.hi.v.Print(mask))
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,44 @@
+package semver
+
+import "testing"
+
+func TestConstraint_Simplify(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{">=1.2.0 <1.3.0 || >=1.2.5 <1.2.9", ">=1.2.0 <1.3.0"},
+		{">=1.2.0 <1.3.0 || >=1.2.5", ">=1.2.0"},
+		{">=1.2.0 <1.3.0 || ~1.2.5 || 1.3.x", ">=1.2.0 <1.4.0-0"},
+		{"<1.3.0 || >=1.3.0", "*"},
+		{"<1.3.0 || >1.3.0", "<1.3.0 || >1.3.0"},
+		{"<=1.3.0 || >1.3.0", "*"},
+		{"^2 || ^1.2", ">=1.2.0 <2.0.0-0 || >=2.0.0 <3.0.0-0"},
+		{"^1.2 !=1.4.0", ">=1.2.0 <1.4.0 || >1.4.0 <2.0.0-0"},
+		{">=1.0.0 <=1.0.0", "1.0.0"},
+		{"v1.2.3 || =1.2.3+b", "1.2.3"},
+		{">2.0.0 <1.0.0", constraintNone},
+		{"1.2.3 !=1.2.3", constraintNone},
+		{">2.0.0 <1.0.0 || 1.x", ">=1.0.0 <2.0.0-0"},
+		{"", "*"},
+	}
+
+	for _, tt := range tests {
+		c := MustConstraint(tt.in)
+		s := c.Simplify()
+		if got := s.String(); got != tt.want {
+			t.Errorf("Simplify(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+
+		// the simplified constraint is equivalent to the input
+		for _, v := range mustList(
+			"0.0.0", "0.9.0", "1.0.0", "1.2.0-rc.1", "1.2.0", "1.2.7", "1.2.9",
+			"1.3.0", "1.3.5", "1.4.0", "1.4.1", "2.0.0-rc.1", "2.0.0", "2.5.0", "3.0.0",
+		) {
+			opts := CheckOptions{IncludePrerelease: true}
+			if c.CheckWith(v, opts) != s.CheckWith(v, opts) {
+				t.Errorf("Simplify(%q) = %q disagrees on %s", tt.in, s, v.Original)
+			}
+		}
+	}
+}
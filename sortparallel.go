@@ -0,0 +1,95 @@
+package semver
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// ParallelSortThreshold is the list length below which SortParallel falls
+// back to Sort: for smaller lists the goroutine and merge overhead outweighs
+// the gain (see BenchmarkSortParallel).
+const ParallelSortThreshold = 100_000
+
+// SortParallel sorts the list in ascending semver order like Sort, splitting
+// the work across workers goroutines (runtime.GOMAXPROCS(0) if workers <= 0).
+// Chunks are sorted concurrently and then merged pairwise in parallel, which
+// needs a temporary buffer of len(ls). The result is identical to Sort.
+func (ls List) SortParallel(workers int) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers == 1 || len(ls) < ParallelSortThreshold {
+		ls.Sort()
+		return
+	}
+
+	// chunk boundaries
+	size := (len(ls) + workers - 1) / workers
+	bounds := make([]int, 0, workers+1)
+	for i := 0; i < len(ls); i += size {
+		bounds = append(bounds, i)
+	}
+	bounds = append(bounds, len(ls))
+
+	var wg sync.WaitGroup
+	for i := 0; i+1 < len(bounds); i++ {
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			slices.SortFunc(ls[lo:hi], CompareForSort)
+		}(bounds[i], bounds[i+1])
+	}
+	wg.Wait()
+
+	// pairwise merge rounds, ping-ponging between ls and buf
+	buf := make(List, len(ls))
+	src, dst := ls, buf
+	for len(bounds) > 2 {
+		next := make([]int, 0, len(bounds)/2+2)
+		for i := 0; i+1 < len(bounds); i += 2 {
+			lo := bounds[i]
+			next = append(next, lo)
+
+			if i+2 >= len(bounds) {
+				// odd run out: copy as is
+				copy(dst[lo:bounds[i+1]], src[lo:bounds[i+1]])
+				continue
+			}
+
+			wg.Add(1)
+			go func(lo, mid, hi int) {
+				defer wg.Done()
+				mergeRuns(dst[lo:hi], src[lo:mid], src[mid:hi])
+			}(lo, bounds[i+1], bounds[i+2])
+		}
+		next = append(next, len(ls))
+		wg.Wait()
+
+		bounds = next
+		src, dst = dst, src
+	}
+
+	if &src[0] != &ls[0] {
+		copy(ls, src)
+	}
+}
+
+// mergeRuns merges sorted a and b into dst (len(dst) == len(a)+len(b)).
+// Elements of a win ties, keeping the merge stable.
+func mergeRuns(dst, a, b List) {
+	i, j, k := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		if less(b[j], a[i]) {
+			dst[k] = b[j]
+			j++
+		} else {
+			dst[k] = a[i]
+			i++
+		}
+		k++
+	}
+
+	k += copy(dst[k:], a[i:])
+	copy(dst[k:], b[j:])
+}
@@ -0,0 +1,65 @@
+package semver
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// randomList returns n random versions, some with prerelease, build or prefix.
+func randomList(n int, seed int64) List {
+	rng := rand.New(rand.NewSource(seed))
+	ls := make(List, n)
+	for i := range ls {
+		s := fmt.Sprintf("%d.%d.%d", rng.Intn(10), rng.Intn(50), rng.Intn(100))
+		switch rng.Intn(5) {
+		case 0:
+			s += fmt.Sprintf("-rc.%d", rng.Intn(20))
+		case 1:
+			s += "+build"
+		case 2:
+			s = "v" + s
+		}
+		ls[i], _ = Parse(s)
+	}
+
+	return ls
+}
+
+// TestSortParallel checks SortParallel against Sort for several worker counts.
+func TestSortParallel(t *testing.T) {
+	for _, workers := range []int{0, 1, 2, 3, 7, 16} {
+		ls := randomList(ParallelSortThreshold+1234, int64(workers))
+		want := append(List(nil), ls...)
+		want.Sort()
+
+		ls.SortParallel(workers)
+		for i := range ls {
+			if ls[i].Original != want[i].Original {
+				t.Fatalf("SortParallel(%d)[%d] = %q, want %q", workers, i, ls[i].Original, want[i].Original)
+			}
+		}
+	}
+}
+
+// BenchmarkSortParallel compares Sort and SortParallel around the crossover point.
+func BenchmarkSortParallel(b *testing.B) {
+	for _, n := range []int{10_000, 100_000, 1_000_000} {
+		src := randomList(n, 1)
+		ls := make(List, n)
+
+		b.Run(fmt.Sprintf("Sort/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(ls, src)
+				ls.Sort()
+			}
+		})
+
+		b.Run(fmt.Sprintf("SortParallel/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(ls, src)
+				ls.SortParallel(0)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+/*
+Package stream sorts version lists that do not fit into memory.
+
+Sorter reads versions from an io.Reader (one per line), sorts chunks bounded
+by a memory budget with semver.List ordering, spills them into temporary
+files and k-way merges the runs into the output. Input lines are emitted
+unchanged, so the output can be fed to other tools as-is.
+*/
+package stream
+
+import (
+	"bufio"
+	"container/heap"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/woozymasta/semver"
+)
+
+// DefaultMemoryLimit is the memory budget used when Sorter.MemoryLimit is not set.
+const DefaultMemoryLimit = 64 << 20
+
+// entryOverhead approximates the in-memory size of a semver.Semver
+// excluding the bytes of its Original string.
+const entryOverhead = 96
+
+// Sorter is an external merge sorter for newline separated versions.
+// The zero value is ready to use.
+type Sorter struct {
+	// TempDir directory for spill files; empty means os.TempDir().
+	TempDir string
+
+	// MemoryLimit approximate number of bytes of versions kept in memory
+	// before a sorted run is spilled to disk; <= 0 means DefaultMemoryLimit.
+	MemoryLimit int
+}
+
+// Sort reads versions from r, one per line, and writes them to w in
+// ascending semver order (the order of semver.List.Sort). Surrounding
+// whitespace is trimmed and empty lines are dropped; invalid versions are
+// kept and sort first, like in semver.List.
+func (s *Sorter) Sort(r io.Reader, w io.Writer) error {
+	limit := s.MemoryLimit
+	if limit <= 0 {
+		limit = DefaultMemoryLimit
+	}
+
+	var runs []string
+	defer func() {
+		for _, name := range runs {
+			_ = os.Remove(name)
+		}
+	}()
+
+	var chunk semver.List
+	used := 0
+
+	sc := newScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+
+		v, _ := semver.Parse(line)
+		chunk = append(chunk, v)
+		used += len(line) + entryOverhead
+
+		if used >= limit {
+			name, err := s.spill(chunk)
+			if err != nil {
+				return err
+			}
+			runs = append(runs, name)
+			chunk, used = chunk[:0], 0
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+
+	// everything fits into memory
+	if len(runs) == 0 {
+		chunk.Sort()
+		if err := writeList(bw, chunk); err != nil {
+			return err
+		}
+		return bw.Flush()
+	}
+
+	if len(chunk) > 0 {
+		name, err := s.spill(chunk)
+		if err != nil {
+			return err
+		}
+		runs = append(runs, name)
+	}
+
+	if err := merge(bw, runs); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+// spill sorts chunk and writes it into a new temporary file.
+func (s *Sorter) spill(chunk semver.List) (string, error) {
+	chunk.Sort()
+
+	f, err := os.CreateTemp(s.TempDir, "semver-sort-*")
+	if err != nil {
+		return "", err
+	}
+
+	bw := bufio.NewWriter(f)
+	if err := writeList(bw, chunk); err != nil {
+		_ = f.Close()
+		return f.Name(), err
+	}
+	if err := bw.Flush(); err != nil {
+		_ = f.Close()
+		return f.Name(), err
+	}
+
+	return f.Name(), f.Close()
+}
+
+// writeList writes Original of each version on its own line.
+func writeList(w *bufio.Writer, ls semver.List) error {
+	for _, v := range ls {
+		if _, err := w.WriteString(v.Original); err != nil {
+			return err
+		}
+		if err := w.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// merge k-way merges sorted run files into w.
+func merge(w *bufio.Writer, runs []string) error {
+	h := make(mergeHeap, 0, len(runs))
+	for _, name := range runs {
+		f, err := os.Open(name)
+		if err != nil {
+			h.close()
+			return err
+		}
+
+		src := &mergeSource{f: f, sc: newScanner(f)}
+		if src.next() {
+			h = append(h, src)
+		} else {
+			_ = f.Close()
+		}
+	}
+	defer h.close()
+	heap.Init(&h)
+
+	for h.Len() > 0 {
+		src := h[0]
+		if _, err := w.WriteString(src.v.Original); err != nil {
+			return err
+		}
+		if err := w.WriteByte('\n'); err != nil {
+			return err
+		}
+
+		if src.next() {
+			heap.Fix(&h, 0)
+			continue
+		}
+		if err := src.sc.Err(); err != nil {
+			return err
+		}
+
+		_ = src.f.Close()
+		heap.Pop(&h)
+	}
+
+	return nil
+}
+
+// mergeSource is a sorted run being merged.
+type mergeSource struct {
+	f  *os.File
+	sc *bufio.Scanner
+	v  semver.Semver
+}
+
+// next advances to the next version of the run.
+func (m *mergeSource) next() bool {
+	if !m.sc.Scan() {
+		return false
+	}
+
+	m.v, _ = semver.Parse(m.sc.Text())
+	return true
+}
+
+// mergeHeap is a min-heap of runs keyed by their current version.
+type mergeHeap []*mergeSource
+
+func (h mergeHeap) Len() int { return len(h) }
+
+func (h mergeHeap) Less(i, j int) bool {
+	return semver.CompareForSort(h[i].v, h[j].v) < 0
+}
+
+func (h mergeHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *mergeHeap) Push(x any) { *h = append(*h, x.(*mergeSource)) }
+
+func (h *mergeHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// close closes all files still referenced by the heap.
+func (h mergeHeap) close() {
+	for _, src := range h {
+		_ = src.f.Close()
+	}
+}
+
+// newScanner returns a line scanner accepting long lines.
+func newScanner(r io.Reader) *bufio.Scanner {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	return sc
+}
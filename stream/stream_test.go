@@ -0,0 +1,72 @@
+package stream
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/woozymasta/semver"
+)
+
+// TestSorter_Spill compares external sorting against in-memory List.Sort.
+func TestSorter_Spill(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	var in []string
+	for i := 0; i < 2000; i++ {
+		s := fmt.Sprintf("%d.%d.%d", rng.Intn(5), rng.Intn(20), rng.Intn(50))
+		switch rng.Intn(4) {
+		case 0:
+			s += fmt.Sprintf("-rc.%d", rng.Intn(10))
+		case 1:
+			s = "v" + s
+		}
+		in = append(in, s)
+	}
+	in = append(in, "bad", "", "  1.0.0  ")
+
+	want := make(semver.List, 0, len(in))
+	for _, s := range in {
+		if s = strings.TrimSpace(s); s != "" {
+			v, _ := semver.Parse(s)
+			want = append(want, v)
+		}
+	}
+	want.Sort()
+
+	dir := t.TempDir()
+	var out bytes.Buffer
+	s := Sorter{MemoryLimit: 4096, TempDir: dir}
+	if err := s.Sort(strings.NewReader(strings.Join(in, "\n")), &out); err != nil {
+		t.Fatalf("Sort: %v", err)
+	}
+
+	got := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(got) != len(want) {
+		t.Fatalf("Sort: got %d lines, want %d", len(got), len(want))
+	}
+	for i := range got {
+		if got[i] != want[i].Original {
+			t.Fatalf("Sort: line %d = %q, want %q", i, got[i], want[i].Original)
+		}
+	}
+
+	if files, _ := os.ReadDir(dir); len(files) != 0 {
+		t.Fatalf("Sort left %d spill files behind", len(files))
+	}
+}
+
+// TestSorter_InMemory checks the zero value Sorter without spilling.
+func TestSorter_InMemory(t *testing.T) {
+	var s Sorter
+	var out bytes.Buffer
+	if err := s.Sort(strings.NewReader("2.0.0\n1.0.0\n1.0.0-rc.1\n"), &out); err != nil {
+		t.Fatalf("Sort: %v", err)
+	}
+	if got := out.String(); got != "1.0.0-rc.1\n1.0.0\n2.0.0\n" {
+		t.Fatalf("Sort = %q", got)
+	}
+}
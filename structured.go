@@ -0,0 +1,156 @@
+package semver
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ToMap returns v as a map with a stable schema for policy engines (OPA
+// Rego, CUE, CEL) that should reason over components instead of parsing
+// version strings:
+//
+//	{
+//		"version":    "1.2.3-rc.1+build.5", // SemVer form, no prefix
+//		"major":      1,
+//		"minor":      2,
+//		"patch":      3,
+//		"prerelease": []any{"rc", 1},       // numeric identifiers as int
+//		"build":      []any{"build", "5"},  // always strings
+//	}
+//
+// All keys are always present; prerelease and build are empty lists when
+// absent. Numeric prerelease identifiers too large for int stay strings.
+// Epoch and revision are not part of the schema. Returns nil for invalid
+// versions.
+func (v Semver) ToMap() map[string]any {
+	if !v.Valid {
+		return nil
+	}
+
+	pre := make([]any, 0, 4)
+	for _, id := range v.PrereleaseIdentifiers() {
+		if n, err := strconv.Atoi(id.Value); id.Numeric && err == nil {
+			pre = append(pre, n)
+		} else {
+			pre = append(pre, id.Value)
+		}
+	}
+
+	build := make([]any, 0, 4)
+	for _, id := range v.BuildIdentifiers() {
+		build = append(build, id)
+	}
+
+	return map[string]any{
+		"version":    v.SemVer(),
+		"major":      v.Major,
+		"minor":      v.Minor,
+		"patch":      v.Patch,
+		"prerelease": pre,
+		"build":      build,
+	}
+}
+
+// FromMap builds a version from a map in the ToMap schema and validates it
+// like Parse. "major", "minor" and "patch" are required; "prerelease" and
+// "build" are optional lists. Numbers may be any Go integer type, an
+// integral float64 or a json.Number, as produced by decoders; identifiers
+// may be strings or numbers. "version" and unknown keys are ignored, the
+// components are authoritative. Returns (zero, false) on any mismatch.
+func FromMap(m map[string]any) (Semver, bool) {
+	var b strings.Builder
+	for i, key := range [...]string{"major", "minor", "patch"} {
+		n, ok := mapNumber(m[key])
+		if !ok {
+			return Semver{}, false
+		}
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(n)
+	}
+
+	for _, part := range [...]struct {
+		key string
+		sep byte
+	}{{"prerelease", '-'}, {"build", '+'}} {
+		raw, ok := m[part.key]
+		if !ok || raw == nil {
+			continue
+		}
+		list, ok := raw.([]any)
+		if !ok {
+			if strs, isStrs := raw.([]string); isStrs {
+				list = make([]any, len(strs))
+				for i, s := range strs {
+					list[i] = s
+				}
+			} else {
+				return Semver{}, false
+			}
+		}
+
+		for i, id := range list {
+			s, ok := id.(string)
+			if !ok {
+				if s, ok = mapNumber(id); !ok {
+					return Semver{}, false
+				}
+			}
+			if i == 0 {
+				b.WriteByte(part.sep)
+			} else {
+				b.WriteByte('.')
+			}
+			b.WriteString(s)
+		}
+	}
+
+	v, ok := Parse(b.String())
+	if !ok {
+		return Semver{}, false
+	}
+
+	return v, true
+}
+
+// mapNumber renders a non-negative integer from a decoded map value.
+func mapNumber(x any) (string, bool) {
+	var n int64
+	switch x := x.(type) {
+	case int:
+		n = int64(x)
+	case int8:
+		n = int64(x)
+	case int16:
+		n = int64(x)
+	case int32:
+		n = int64(x)
+	case int64:
+		n = x
+	case uint:
+		return strconv.FormatUint(uint64(x), 10), true
+	case uint8:
+		n = int64(x)
+	case uint16:
+		n = int64(x)
+	case uint32:
+		n = int64(x)
+	case uint64:
+		return strconv.FormatUint(x, 10), true
+	case float64:
+		if x != math.Trunc(x) || x < 0 || x >= math.MaxInt64 {
+			return "", false
+		}
+		n = int64(x)
+	case json.Number:
+		s := x.String()
+		return s, s != "" && isNum(s)
+	default:
+		return "", false
+	}
+
+	return strconv.FormatInt(n, 10), n >= 0
+}
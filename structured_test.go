@@ -0,0 +1,84 @@
+package semver
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestToMap(t *testing.T) {
+	v := MustParse("v1.2.3-rc.1.x-y.99999999999999999999+build.005")
+	want := map[string]any{
+		"version":    "1.2.3-rc.1.x-y.99999999999999999999+build.005",
+		"major":      1,
+		"minor":      2,
+		"patch":      3,
+		"prerelease": []any{"rc", 1, "x-y", "99999999999999999999"},
+		"build":      []any{"build", "005"},
+	}
+	if got := v.ToMap(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ToMap = %#v, want %#v", got, want)
+	}
+
+	if got := MustParse("1.0.0").ToMap(); len(got["prerelease"].([]any)) != 0 || len(got["build"].([]any)) != 0 {
+		t.Errorf("ToMap(1.0.0) = %#v", got)
+	}
+	if got := (Semver{Original: "bogus"}).ToMap(); got != nil {
+		t.Errorf("ToMap(invalid) = %#v", got)
+	}
+}
+
+func TestFromMap(t *testing.T) {
+	tests := []struct {
+		in   map[string]any
+		want string // SemVer form, "" if invalid
+	}{
+		{map[string]any{"major": 1, "minor": 2, "patch": 3}, "1.2.3"},
+		{map[string]any{"major": int64(1), "minor": uint8(0), "patch": 7.0, "prerelease": []any{"rc", 2}}, "1.0.7-rc.2"},
+		{map[string]any{"major": json.Number("2"), "minor": 0, "patch": 0, "build": []string{"sha", "abc"}}, "2.0.0+sha.abc"},
+		{map[string]any{"major": 1, "minor": 0, "patch": 0, "prerelease": nil, "version": "9.9.9"}, "1.0.0"},
+
+		{map[string]any{"major": 1, "minor": 2}, ""},
+		{map[string]any{"major": -1, "minor": 0, "patch": 0}, ""},
+		{map[string]any{"major": 1.5, "minor": 0, "patch": 0}, ""},
+		{map[string]any{"major": "1", "minor": 0, "patch": 0}, ""},
+		{map[string]any{"major": 1, "minor": 0, "patch": 0, "prerelease": "rc.1"}, ""},
+		{map[string]any{"major": 1, "minor": 0, "patch": 0, "prerelease": []any{"rc", true}}, ""},
+		{map[string]any{"major": 1, "minor": 0, "patch": 0, "prerelease": []any{"01"}}, ""},
+		{map[string]any{"major": 1, "minor": 0, "patch": 0, "build": []any{""}}, ""},
+	}
+
+	for _, tt := range tests {
+		v, ok := FromMap(tt.in)
+		if tt.want == "" {
+			if ok {
+				t.Errorf("FromMap(%v) = %q, want invalid", tt.in, v.Original)
+			}
+			continue
+		}
+		if !ok || v.SemVer() != tt.want {
+			t.Errorf("FromMap(%v) = %q, %v, want %q", tt.in, v.SemVer(), ok, tt.want)
+		}
+	}
+}
+
+func TestMapRoundTrip(t *testing.T) {
+	for _, s := range []string{"0.0.0", "1.2.3-alpha.1+b.2", "10.20.30-0.a-b.3"} {
+		v := MustParse(s)
+
+		// through JSON, as a policy engine would see it
+		data, err := json.Marshal(v.ToMap())
+		if err != nil {
+			t.Fatal(err)
+		}
+		var m map[string]any
+		if err := json.Unmarshal(data, &m); err != nil {
+			t.Fatal(err)
+		}
+
+		got, ok := FromMap(m)
+		if !ok || got.SemVer() != v.SemVer() {
+			t.Errorf("round trip %q = %q, %v", s, got.SemVer(), ok)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package semver
+
+import (
+	"slices"
+	"strings"
+)
+
+// suggestDepth is the number of repairs Suggest combines at most.
+const suggestDepth = 3
+
+// repairs are the single-step fixes tried by Suggest.
+var repairs = []func(string) string{
+	// stray whitespace
+	func(s string) string { return strings.Join(strings.Fields(s), "") },
+	// '_' used as separator
+	func(s string) string { return strings.ReplaceAll(s, "_", "-") },
+	// empty components and dangling separators
+	func(s string) string { return strings.ReplaceAll(s, "..", ".") },
+	func(s string) string { return strings.TrimRight(s, ".-+") },
+	stripLeadingZeros,
+	truncateCore,
+	completeCore,
+	// text around the version ("release-1.2.3", "version 1.2")
+	func(s string) string {
+		if _, v, ok := ParsePrefixed(s); ok {
+			return v.Original
+		}
+		return s
+	},
+}
+
+// Suggest proposes likely corrections for an invalid version: stripping
+// leading zeros, removing stray spaces, replacing '_' with '-', truncating a
+// fourth numeric segment, completing "1.2-rc" to "1.2.0-rc" and similar.
+// Up to three fixes are combined; the valid results are returned closest
+// first by edit distance to s. Returns nil if s is valid or nothing helps.
+func Suggest(s string) []string {
+	if _, ok := Parse(s); ok {
+		return nil
+	}
+
+	seen := map[string]bool{s: true}
+	frontier := []string{s}
+	var out []string
+
+	for depth := 0; depth < suggestDepth && len(frontier) > 0; depth++ {
+		var next []string
+		for _, cur := range frontier {
+			for _, fix := range repairs {
+				c := fix(cur)
+				if seen[c] {
+					continue
+				}
+				seen[c] = true
+
+				if _, ok := Parse(c); ok {
+					out = append(out, c)
+				} else {
+					next = append(next, c)
+				}
+			}
+		}
+		frontier = next
+	}
+
+	slices.SortStableFunc(out, func(a, b string) int {
+		if da, db := levenshtein(s, a), levenshtein(s, b); da != db {
+			return da - db
+		}
+		return strings.Compare(a, b)
+	})
+
+	return out
+}
+
+// stripLeadingZeros removes leading zeros from numeric core components and
+// numeric prerelease identifiers ("01.02.3-rc.01" -> "1.2.3-rc.1").
+func stripLeadingZeros(s string) string {
+	head, build, hasBuild := strings.Cut(s, "+")
+
+	parts := strings.Split(head, ".")
+	for i, p := range parts {
+		prefix := ""
+		if i == 0 && p != "" && (p[0] == 'v' || p[0] == 'V') {
+			prefix, p = p[:1], p[1:]
+		}
+
+		// a core component may carry the prerelease start ("3-rc")
+		num, rest := p, ""
+		if j := strings.IndexByte(p, '-'); j >= 0 {
+			num, rest = p[:j], p[j:]
+		}
+
+		if len(num) > 1 && isNum(num) {
+			num = strings.TrimLeft(num, "0")
+			if num == "" {
+				num = "0"
+			}
+		}
+		parts[i] = prefix + num + rest
+	}
+
+	out := strings.Join(parts, ".")
+	if hasBuild {
+		out += "+" + build
+	}
+
+	return out
+}
+
+// truncateCore drops numeric core components after PATCH ("1.2.3.4" -> "1.2.3").
+func truncateCore(s string) string {
+	end := len(s)
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		end = i
+	}
+
+	parts := strings.Split(s[:end], ".")
+	if len(parts) <= 3 {
+		return s
+	}
+
+	return strings.Join(parts[:3], ".") + s[end:]
+}
+
+// completeCore pads a shorthand core followed by prerelease or build
+// ("1.2-rc" -> "1.2.0-rc").
+func completeCore(s string) string {
+	end := strings.IndexAny(s, "-+")
+	if end < 0 {
+		return s
+	}
+
+	n := strings.Count(s[:end], ".")
+	if n >= 2 {
+		return s
+	}
+
+	return s[:end] + strings.Repeat(".0", 2-n) + s[end:]
+}
+
+// levenshtein returns the edit distance between a and b in bytes.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(b)]
+}
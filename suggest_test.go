@@ -0,0 +1,63 @@
+package semver
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSuggest(t *testing.T) {
+	tests := []struct {
+		in    string
+		first string
+	}{
+		{"01.2.3", "1.2.3"},
+		{"1.2.3-rc.01", "1.2.3-rc.1"},
+		{" 1.2.3 ", "1.2.3"},
+		{"1. 2.3", "1.2.3"},
+		{"1.2.3_rc1", "1.2.3-rc1"},
+		{"1.2.3.4", "1.2.3"},
+		{"1.2-rc.1", "1.2.0-rc.1"},
+		{"v1.2.3.", "v1.2.3"},
+		{"1..2.3", "1.2.3"},
+		{"release-1.4.0", "1.4.0"},
+		{"v01.2.3.4_beta", "v1.2.3-beta"},
+	}
+
+	for _, tt := range tests {
+		got := Suggest(tt.in)
+		if len(got) == 0 || got[0] != tt.first {
+			t.Errorf("Suggest(%q) = %q, want first %q", tt.in, got, tt.first)
+		}
+		for _, s := range got {
+			if _, ok := Parse(s); !ok {
+				t.Errorf("Suggest(%q) proposed invalid %q", tt.in, s)
+			}
+		}
+	}
+
+	if got := Suggest("1.2.3"); got != nil {
+		t.Errorf("Suggest(valid) = %q, want nil", got)
+	}
+	if got := Suggest("hello"); got != nil {
+		t.Errorf("Suggest(hello) = %q, want nil", got)
+	}
+	if got := Suggest("1.2.3.4"); slices.Contains(got, "1.2.3.4") {
+		t.Errorf("Suggest returned its input")
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	for _, tt := range []struct {
+		a, b string
+		want int
+	}{
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"1.2.3", "1.2.3", 0},
+		{"01.2.3", "1.2.3", 1},
+	} {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
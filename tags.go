@@ -0,0 +1,74 @@
+package semver
+
+// TagAliasOptions controls TagAliases.
+type TagAliasOptions struct {
+	// List of already known versions. It decides which floating tags v may
+	// take over; when nil, v is assumed to be the newest version overall.
+	List List
+
+	// Latest is the name of the floating "newest stable" tag.
+	// Empty defaults to "latest".
+	Latest string
+
+	// PrefixV renders version tags with a lowercase 'v' ("v1", "v1.2", ...).
+	PrefixV bool
+}
+
+// TagAliases returns the tags v should be published under following the
+// common container tagging strategy, least specific first and "latest" last:
+//
+//	"1", "1.2", "1.2.3", "latest"
+//
+// Prereleases only get their exact tag ("1.2.3-rc.1"). Floating "MAJOR" and
+// "MAJOR.MINOR" tags are only returned if no stable version in opts.List is
+// newer within that track, and "latest" only if v is the highest stable version.
+// Build metadata is never rendered ('+' is not allowed in OCI tags).
+// Returns nil for invalid versions.
+func (v Semver) TagAliases(opts TagAliasOptions) []string {
+	if !v.Valid {
+		return nil
+	}
+
+	prefix := PrintPrefixNoV
+	if opts.PrefixV {
+		prefix = PrintPrefixV
+	}
+
+	exact := v.Print(prefix | PrintMaskRelease | PrintPrerelease)
+	if v.HasPre() {
+		return []string{exact}
+	}
+
+	newestMajor, newestMinor, newest := true, true, true
+	for _, w := range opts.List {
+		if !w.Valid || w.HasPre() || !w.IsGreater(v) {
+			continue
+		}
+
+		newest = false
+		if w.Major == v.Major {
+			newestMajor = false
+			if w.Minor == v.Minor {
+				newestMinor = false
+			}
+		}
+	}
+
+	tags := make([]string, 0, 4)
+	if newestMajor {
+		tags = append(tags, v.Print(prefix|PrintMajor))
+	}
+	if newestMinor {
+		tags = append(tags, v.Print(prefix|PrintMajor|PrintMinor))
+	}
+	tags = append(tags, exact)
+	if newest {
+		latest := opts.Latest
+		if latest == "" {
+			latest = "latest"
+		}
+		tags = append(tags, latest)
+	}
+
+	return tags
+}
@@ -0,0 +1,32 @@
+package semver
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestTagAliases checks floating tag selection against a list of known versions.
+func TestTagAliases(t *testing.T) {
+	known := mustList("1.2.2", "1.3.0", "2.0.0", "2.1.0-rc.1")
+
+	tests := []struct {
+		in   string
+		opts TagAliasOptions
+		want []string
+	}{
+		{"1.2.3", TagAliasOptions{}, []string{"1", "1.2", "1.2.3", "latest"}},
+		{"1.2.3+build.7", TagAliasOptions{PrefixV: true}, []string{"v1", "v1.2", "v1.2.3", "latest"}},
+		{"1.2.3", TagAliasOptions{List: known}, []string{"1.2", "1.2.3"}},
+		{"1.3.1", TagAliasOptions{List: known}, []string{"1", "1.3", "1.3.1"}},
+		{"2.0.1", TagAliasOptions{List: known, Latest: "stable"}, []string{"2", "2.0", "2.0.1", "stable"}},
+		{"2.1.0-rc.2", TagAliasOptions{List: known}, []string{"2.1.0-rc.2"}},
+		{"bad", TagAliasOptions{}, nil},
+	}
+
+	for _, tt := range tests {
+		v, _ := Parse(tt.in)
+		if got := v.TagAliases(tt.opts); !slices.Equal(got, tt.want) {
+			t.Errorf("TagAliases(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
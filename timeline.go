@@ -0,0 +1,118 @@
+package semver
+
+import (
+	"slices"
+	"sort"
+	"time"
+)
+
+// VersionedAt is a version with its release time.
+type VersionedAt struct {
+	Time    time.Time
+	Version Semver
+}
+
+// WithTimes pairs each version of ls with the timestamp at the same index.
+// When the lengths differ, the extra elements of the longer slice are ignored.
+func (ls List) WithTimes(times []time.Time) []VersionedAt {
+	n := min(len(ls), len(times))
+
+	out := make([]VersionedAt, n)
+	for i := 0; i < n; i++ {
+		out[i] = VersionedAt{Version: ls[i], Time: times[i]}
+	}
+
+	return out
+}
+
+// Timeline is an immutable release history answering point-in-time queries,
+// e.g. to reproduce a resolution decision made in the past.
+// The zero value is an empty timeline.
+type Timeline struct {
+	// entries sorted by Time, ties in input order
+	entries []VersionedAt
+}
+
+// NewTimeline builds a Timeline from entries. Invalid versions are dropped;
+// entries is not modified.
+func NewTimeline(entries []VersionedAt) Timeline {
+	out := make([]VersionedAt, 0, len(entries))
+	for _, e := range entries {
+		if e.Version.Valid {
+			out = append(out, e)
+		}
+	}
+
+	slices.SortStableFunc(out, func(a, b VersionedAt) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	return Timeline{entries: out}
+}
+
+// Len returns the number of releases in the timeline.
+func (tl Timeline) Len() int {
+	return len(tl.entries)
+}
+
+// At returns the versions released at or before t in ascending semver order.
+func (tl Timeline) At(t time.Time) List {
+	n := tl.upTo(t)
+
+	out := make(List, n)
+	for i := 0; i < n; i++ {
+		out[i] = tl.entries[i].Version
+	}
+	out.Sort()
+
+	return out
+}
+
+// LatestAt returns the highest version released at or before t.
+// Returns (zero, false) if nothing was released yet.
+func (tl Timeline) LatestAt(t time.Time) (Semver, bool) {
+	return tl.best(t, nil)
+}
+
+// ResolveAt returns the highest version released at or before t that
+// satisfies c, i.e. what Resolve would have returned at that moment.
+// Returns (zero, false) if there is no such version.
+func (tl Timeline) ResolveAt(t time.Time, c Constraint) (Semver, bool) {
+	return tl.best(t, c.Check)
+}
+
+// best returns the highest version released at or before t accepted by
+// match (any version if match is nil).
+func (tl Timeline) best(t time.Time, match func(Semver) bool) (Semver, bool) {
+	var best Semver
+	found := false
+	for _, e := range tl.entries[:tl.upTo(t)] {
+		if (match == nil || match(e.Version)) && (!found || e.Version.IsGreater(best)) {
+			best, found = e.Version, true
+		}
+	}
+
+	return best, found
+}
+
+// Between returns the releases with from <= Time < to in chronological order.
+func (tl Timeline) Between(from, to time.Time) []VersionedAt {
+	lo := sort.Search(len(tl.entries), func(i int) bool {
+		return !tl.entries[i].Time.Before(from)
+	})
+	hi := sort.Search(len(tl.entries), func(i int) bool {
+		return !tl.entries[i].Time.Before(to)
+	})
+	if lo >= hi {
+		return nil
+	}
+
+	return slices.Clone(tl.entries[lo:hi])
+}
+
+// upTo returns the number of entries released at or before t.
+func (tl Timeline) upTo(t time.Time) int {
+	return sort.Search(len(tl.entries), func(i int) bool {
+		return tl.entries[i].Time.After(t)
+	})
+}
@@ -0,0 +1,61 @@
+package semver
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeline(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	ls := mustList("1.0.0", "2.0.0-rc.1", "1.1.0", "bad", "2.0.0", "1.1.1")
+	tl := NewTimeline(ls.WithTimes([]time.Time{day(1), day(5), day(3), day(4), day(9), day(10)}))
+	if tl.Len() != 5 {
+		t.Fatalf("Len = %d, want 5", tl.Len())
+	}
+
+	if _, ok := tl.LatestAt(day(0)); ok {
+		t.Fatalf("LatestAt before first release succeeded")
+	}
+
+	latest := []struct {
+		at   time.Time
+		want string
+	}{
+		{day(1), "1.0.0"},
+		{day(4), "1.1.0"},
+		{day(6), "2.0.0-rc.1"},
+		{day(31), "2.0.0"},
+	}
+	for _, tt := range latest {
+		if got, ok := tl.LatestAt(tt.at); !ok || got.Original != tt.want {
+			t.Errorf("LatestAt(%v) = %q, %v; want %q", tt.at, got.Original, ok, tt.want)
+		}
+	}
+
+	// 1.1.1 is a backport released after 2.0.0
+	got, ok := tl.ResolveAt(day(10), MustConstraint("^1"))
+	if !ok || got.Original != "1.1.1" {
+		t.Errorf("ResolveAt(^1) = %q, %v", got.Original, ok)
+	}
+
+	snap := tl.At(day(5))
+	if len(snap) != 3 || snap[0].Original != "1.0.0" || snap[2].Original != "2.0.0-rc.1" {
+		t.Errorf("At = %v", snap)
+	}
+
+	between := tl.Between(day(3), day(9))
+	if len(between) != 2 || between[0].Version.Original != "1.1.0" || between[1].Version.Original != "2.0.0-rc.1" {
+		t.Errorf("Between = %v", between)
+	}
+	if got := tl.Between(day(9), day(9)); got != nil {
+		t.Errorf("empty Between = %v", got)
+	}
+}
+
+func TestList_WithTimes(t *testing.T) {
+	ls := mustList("1.0.0", "1.1.0")
+	if got := ls.WithTimes([]time.Time{{}}); len(got) != 1 {
+		t.Fatalf("WithTimes len = %d, want 1", len(got))
+	}
+}
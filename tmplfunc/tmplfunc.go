@@ -0,0 +1,176 @@
+/*
+Package tmplfunc provides semver functions for text/template and
+html/template, for Helm-like manifests and code generation templates:
+
+	{{ $v := semverParse .Version }}
+	image: app:{{ $v | semverBump "minor" }}
+	{{ if semverSatisfies ">=1.4.0" .Version }}feature: on{{ end }}
+	{{ range semverSort .Tags }}{{ . }} {{ end }}
+
+Versions may be passed as strings, semver.Semver or *semver.Semver;
+functions returning a version return *semver.Semver, which prints as
+String and exposes fields and methods ({{ (semverParse "v1.2.3").Major }}).
+Invalid input fails template execution with an error wrapping
+semver.ErrInvalidVersion or semver.ErrInvalidConstraint.
+*/
+package tmplfunc
+
+import (
+	"fmt"
+	"text/template"
+
+	"github.com/woozymasta/semver"
+)
+
+// FuncMap returns the template functions:
+//
+//	semverParse     string -> *Semver
+//	semverCompare   a b -> -1, 0 or +1
+//	semverBump      "major"|"minor"|"patch"|"prerelease" v -> *Semver
+//	semverSatisfies constraint v -> bool
+//	semverSort      list -> []string, ascending
+//
+// The version is the last argument so functions work in pipelines.
+// The map is fresh on every call; convert it with html/template.FuncMap
+// for HTML templates.
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"semverParse":     parse,
+		"semverCompare":   compare,
+		"semverBump":      bump,
+		"semverSatisfies": satisfies,
+		"semverSort":      sortVersions,
+	}
+}
+
+// bumpKinds maps semverBump kind names to semver.BumpKind.
+var bumpKinds = map[string]semver.BumpKind{
+	"major":      semver.BumpKindMajor,
+	"minor":      semver.BumpKindMinor,
+	"patch":      semver.BumpKindPatch,
+	"prerelease": semver.BumpKindPrerelease,
+}
+
+// parse implements semverParse.
+func parse(x any) (*semver.Semver, error) {
+	v, err := toSemver(x)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// compare implements semverCompare.
+func compare(a, b any) (int, error) {
+	va, err := toSemver(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := toSemver(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return va.Compare(vb), nil
+}
+
+// bump implements semverBump.
+func bump(kind string, x any) (*semver.Semver, error) {
+	k, ok := bumpKinds[kind]
+	if !ok {
+		return nil, fmt.Errorf("semverBump: unknown kind %q", kind)
+	}
+
+	v, err := toSemver(x)
+	if err != nil {
+		return nil, err
+	}
+
+	next, ok := v.Bump(k)
+	if !ok {
+		return nil, fmt.Errorf("%w: can not bump %s %q", semver.ErrInvalidVersion, kind, v.Original)
+	}
+
+	return &next, nil
+}
+
+// satisfies implements semverSatisfies.
+func satisfies(constraint string, x any) (bool, error) {
+	c, ok := semver.ParseConstraint(constraint)
+	if !ok {
+		return false, fmt.Errorf("%w: %q", semver.ErrInvalidConstraint, constraint)
+	}
+
+	v, err := toSemver(x)
+	if err != nil {
+		return false, err
+	}
+
+	return c.Check(v), nil
+}
+
+// sortVersions implements semverSort for []string, []any and semver.List.
+func sortVersions(x any) ([]string, error) {
+	var ls semver.List
+	switch x := x.(type) {
+	case semver.List:
+		ls = append(ls, x...)
+	case []string:
+		for _, s := range x {
+			v, err := toSemver(s)
+			if err != nil {
+				return nil, err
+			}
+			ls = append(ls, v)
+		}
+	case []any:
+		for _, e := range x {
+			v, err := toSemver(e)
+			if err != nil {
+				return nil, err
+			}
+			ls = append(ls, v)
+		}
+	default:
+		return nil, fmt.Errorf("semverSort: unsupported list type %T", x)
+	}
+
+	ls.Sort()
+
+	out := make([]string, len(ls))
+	for i := range ls {
+		if !ls[i].Valid {
+			return nil, fmt.Errorf("%w: %q", semver.ErrInvalidVersion, ls[i].Original)
+		}
+		out[i] = ls[i].Original
+		if out[i] == "" {
+			out[i] = ls[i].String()
+		}
+	}
+
+	return out, nil
+}
+
+// toSemver converts a template argument to a valid version.
+func toSemver(x any) (semver.Semver, error) {
+	var v semver.Semver
+	switch x := x.(type) {
+	case string:
+		v, _ = semver.Parse(x)
+	case semver.Semver:
+		v = x
+	case *semver.Semver:
+		if x != nil {
+			v = *x
+		}
+	default:
+		return v, fmt.Errorf("%w: unsupported type %T", semver.ErrInvalidVersion, x)
+	}
+
+	if !v.Valid {
+		return v, fmt.Errorf("%w: %q", semver.ErrInvalidVersion, v.Original)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,91 @@
+package tmplfunc
+
+import (
+	"errors"
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/woozymasta/semver"
+)
+
+// render executes tmpl with data and the FuncMap.
+func render(tmpl string, data any) (string, error) {
+	t, err := template.New("t").Funcs(FuncMap()).Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	err = t.Execute(&b, data)
+	return b.String(), err
+}
+
+func TestFuncMap(t *testing.T) {
+	data := map[string]any{
+		"Version": "v1.4.2",
+		"Parsed":  semver.MustParse("2.0.0-rc.1"),
+		"Tags":    []string{"v1.10.0", "v1.2.0", "v1.2.0-rc.1"},
+		"List":    semver.List{semver.MustParse("3.0.0"), semver.MustParse("1.0.0")},
+	}
+
+	tests := []struct {
+		tmpl string
+		want string
+	}{
+		{`{{ semverParse .Version }}`, "v1.4.2"},
+		{`{{ (semverParse .Version).Major }}.{{ (semverParse .Version).Minor }}`, "1.4"},
+		{`{{ semverCompare .Version "1.10.0" }} {{ semverCompare .Parsed "2.0.0-rc.1" }}`, "-1 0"},
+		{`{{ .Version | semverBump "minor" }}`, "v1.5.0"},
+		{`{{ semverParse .Version | semverBump "major" | semverBump "patch" }}`, "v2.0.1"},
+		{`{{ .Parsed | semverBump "prerelease" }}`, "2.0.0-rc.2"},
+		{`{{ .Version | semverBump "prerelease" }}`, "v1.4.3-rc.1"},
+		{`{{ if semverSatisfies "^1.4.0" .Version }}yes{{ else }}no{{ end }}`, "yes"},
+		{`{{ semverSatisfies ">=2.0.0" .Parsed }}`, "false"},
+		{`{{ range semverSort .Tags }}{{ . }} {{ end }}`, "v1.2.0-rc.1 v1.2.0 v1.10.0 "},
+		{`{{ semverSort .List }}`, "[1.0.0 3.0.0]"},
+	}
+
+	for _, tt := range tests {
+		got, err := render(tt.tmpl, data)
+		if err != nil || got != tt.want {
+			t.Errorf("%s = %q, %v, want %q", tt.tmpl, got, err, tt.want)
+		}
+	}
+}
+
+func TestFuncMap_Errors(t *testing.T) {
+	data := map[string]any{"Bad": "not-a-version", "Tags": []string{"1.0.0", "nope"}, "Num": 7}
+
+	tests := []struct {
+		tmpl string
+		want error // nil for errors without a sentinel
+	}{
+		{`{{ semverParse .Bad }}`, semver.ErrInvalidVersion},
+		{`{{ semverCompare "1.0.0" .Bad }}`, semver.ErrInvalidVersion},
+		{`{{ .Bad | semverBump "minor" }}`, semver.ErrInvalidVersion},
+		{`{{ "1.0.0" | semverBump "huge" }}`, nil},
+		{`{{ semverSatisfies ">=bad" "1.0.0" }}`, semver.ErrInvalidConstraint},
+		{`{{ semverSatisfies ">=1.0.0" .Num }}`, semver.ErrInvalidVersion},
+		{`{{ semverSort .Tags }}`, semver.ErrInvalidVersion},
+		{`{{ semverSort .Num }}`, nil},
+	}
+
+	for _, tt := range tests {
+		out, err := render(tt.tmpl, data)
+		if err == nil || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("%s = %q, %v, want error %v", tt.tmpl, out, err, tt.want)
+		}
+	}
+}
+
+func TestFuncMap_HTML(t *testing.T) {
+	tmpl := htmltemplate.Must(htmltemplate.New("t").Funcs(htmltemplate.FuncMap(FuncMap())).Parse(
+		`<b>{{ "1.2.3" | semverBump "patch" }}</b>`))
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, nil); err != nil || b.String() != "<b>1.2.4</b>" {
+		t.Errorf("html = %q, %v", b.String(), err)
+	}
+}
@@ -0,0 +1,87 @@
+package semver
+
+import "container/heap"
+
+// TopK returns the k greatest versions of ls, newest first, in O(n log k)
+// without sorting or modifying ls. Ties are broken exactly like List.Less:
+// among versions of equal precedence the lexicographically greater Original
+// counts as newer, so the result equals the last k elements of a sorted copy
+// in reverse order. Invalid versions are smallest and only returned when
+// fewer than k valid ones exist.
+func (ls List) TopK(k int) List {
+	t := NewTopKAccumulator(k)
+	for _, v := range ls {
+		t.Add(v)
+	}
+
+	return t.Result()
+}
+
+// TopKAccumulator keeps the k greatest versions seen so far in a bounded
+// heap, for streams where the whole List is never materialized.
+// It is not safe for concurrent use.
+type TopKAccumulator struct {
+	h topKHeap
+	k int
+}
+
+// NewTopKAccumulator returns an accumulator keeping at most k versions (k <= 0 keeps none).
+func NewTopKAccumulator(k int) *TopKAccumulator {
+	if k < 0 {
+		k = 0
+	}
+
+	return &TopKAccumulator{k: k, h: make(topKHeap, 0, k)}
+}
+
+// Add offers v to the accumulator.
+func (t *TopKAccumulator) Add(v Semver) {
+	if t.k == 0 {
+		return
+	}
+
+	if len(t.h) < t.k {
+		heap.Push(&t.h, v)
+		return
+	}
+
+	// replace the smallest kept version if v is newer
+	if less(t.h[0], v) {
+		t.h[0] = v
+		heap.Fix(&t.h, 0)
+	}
+}
+
+// Len returns the number of versions currently kept.
+func (t *TopKAccumulator) Len() int {
+	return len(t.h)
+}
+
+// Result returns the kept versions, newest first. The accumulator is not
+// modified and may receive more versions afterwards.
+func (t *TopKAccumulator) Result() List {
+	out := make(List, len(t.h))
+	copy(out, t.h)
+	out.Sort()
+
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+
+	return out
+}
+
+// topKHeap is a min-heap in List order; the root is the oldest kept version.
+type topKHeap []Semver
+
+func (h topKHeap) Len() int           { return len(h) }
+func (h topKHeap) Less(i, j int) bool { return less(h[i], h[j]) }
+func (h topKHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *topKHeap) Push(x any)        { *h = append(*h, x.(Semver)) }
+
+func (h *topKHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
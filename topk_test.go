@@ -0,0 +1,54 @@
+package semver
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestTopK compares TopK with the tail of a fully sorted copy.
+func TestTopK(t *testing.T) {
+	ls := make(List, len(tests))
+	for i, tt := range tests {
+		ls[i], _ = Parse(tt.in)
+	}
+	rand.Shuffle(len(ls), func(i, j int) { ls[i], ls[j] = ls[j], ls[i] })
+
+	sorted := append(List(nil), ls...)
+	sorted.Sort()
+
+	for _, k := range []int{0, 1, 3, 10, len(ls), len(ls) + 5} {
+		got := ls.TopK(k)
+
+		n := k
+		if n > len(ls) {
+			n = len(ls)
+		}
+		if len(got) != n {
+			t.Fatalf("TopK(%d): got %d versions, want %d", k, len(got), n)
+		}
+		for i := 0; i < n; i++ {
+			if want := sorted[len(sorted)-1-i].Original; got[i].Original != want {
+				t.Fatalf("TopK(%d)[%d] = %q, want %q", k, i, got[i].Original, want)
+			}
+		}
+	}
+
+	if got := ls.TopK(-1); len(got) != 0 {
+		t.Fatalf("TopK(-1) = %v, want empty", got)
+	}
+}
+
+// BenchmarkTopK benchmarks selecting 10 newest out of 100k versions.
+func BenchmarkTopK(b *testing.B) {
+	ls := make(List, 100000)
+	for i := range ls {
+		ls[i], _ = Parse(benchInputs[i%len(benchInputs)])
+		ls[i].Patch = i
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sinkInt += len(ls.TopK(10))
+	}
+}
@@ -0,0 +1,43 @@
+package semver
+
+// TruncateToMinor returns v with PATCH zeroed and revision, prerelease and
+// build cleared ("1.4.7-rc.1" -> "1.4.0"), the floor of its MAJOR.MINOR
+// line. Unlike MajorMinorStr it yields a Semver for bucketing and range
+// math; truncation preserves order (a <= b implies the truncations compare
+// the same way or equal). Epoch and the 'v' prefix are kept.
+// Returns (zero, false) if v is invalid.
+func (v Semver) TruncateToMinor() (Semver, bool) {
+	if !v.Valid {
+		return Semver{Original: v.Original, Valid: false}, false
+	}
+
+	nv := v
+	nv.Patch = 0
+	nv.Revision = 0
+	nv.Prerelease, nv.Build = "", ""
+	nv.Flags |= FlagHasMajor | FlagHasMinor | FlagHasPatch
+	nv.Flags &^= (FlagHasRevision | FlagHasPre | FlagHasBuild)
+	nv.Original = nv.Print(PrintMaskDefault)
+
+	return nv, true
+}
+
+// TruncateToMajor returns v with MINOR and PATCH zeroed and revision,
+// prerelease and build cleared ("1.4.7-rc.1" -> "1.0.0"), the floor of its
+// MAJOR line. See TruncateToMinor.
+// Returns (zero, false) if v is invalid.
+func (v Semver) TruncateToMajor() (Semver, bool) {
+	if !v.Valid {
+		return Semver{Original: v.Original, Valid: false}, false
+	}
+
+	nv := v
+	nv.Minor, nv.Patch = 0, 0
+	nv.Revision = 0
+	nv.Prerelease, nv.Build = "", ""
+	nv.Flags |= FlagHasMajor | FlagHasMinor | FlagHasPatch
+	nv.Flags &^= (FlagHasRevision | FlagHasPre | FlagHasBuild)
+	nv.Original = nv.Print(PrintMaskDefault)
+
+	return nv, true
+}
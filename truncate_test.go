@@ -0,0 +1,51 @@
+package semver
+
+import "testing"
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		in, minor, major string
+	}{
+		{"1.4.7-rc.1", "1.4.0", "1.0.0"},
+		{"v1.4.7+build.5", "v1.4.0", "v1.0.0"},
+		{"v2", "v2.0.0", "v2.0.0"},
+		{"0.0.1", "0.0.0", "0.0.0"},
+	}
+
+	for _, tt := range tests {
+		v := MustParse(tt.in)
+		minor, ok1 := v.TruncateToMinor()
+		major, ok2 := v.TruncateToMajor()
+		if !ok1 || !ok2 || minor.Original != tt.minor || major.Original != tt.major {
+			t.Errorf("%s: TruncateToMinor = %q, TruncateToMajor = %q, want %q, %q",
+				tt.in, minor.Original, major.Original, tt.minor, tt.major)
+		}
+		if minor.HasPre() || minor.HasBuild() || !minor.HasPatch() || major.HasPre() || !major.HasMinor() {
+			t.Errorf("%s: flags not updated: %08b %08b", tt.in, minor.Flags, major.Flags)
+		}
+	}
+
+	var zero Semver
+	if _, ok := zero.TruncateToMinor(); ok {
+		t.Error("TruncateToMinor(invalid) ok")
+	}
+	if _, ok := zero.TruncateToMajor(); ok {
+		t.Error("TruncateToMajor(invalid) ok")
+	}
+}
+
+func TestTruncate_Monotonic(t *testing.T) {
+	ls := mustList("0.9.9", "1.0.0-alpha", "1.0.0", "1.3.9", "1.4.0-rc.1", "1.4.0", "1.4.7", "2.0.0-0", "2.1.0")
+	for i := 1; i < len(ls); i++ {
+		a, _ := ls[i-1].TruncateToMinor()
+		b, _ := ls[i].TruncateToMinor()
+		if a.Compare(b) > 0 {
+			t.Errorf("TruncateToMinor(%s) > TruncateToMinor(%s)", ls[i-1].Original, ls[i].Original)
+		}
+		a, _ = ls[i-1].TruncateToMajor()
+		b, _ = ls[i].TruncateToMajor()
+		if a.Compare(b) > 0 {
+			t.Errorf("TruncateToMajor(%s) > TruncateToMajor(%s)", ls[i-1].Original, ls[i].Original)
+		}
+	}
+}
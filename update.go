@@ -0,0 +1,50 @@
+package semver
+
+// UpdateOptions tunes CheckNewerWith.
+type UpdateOptions struct {
+	// AllowPrerelease lets prereleases be suggested.
+	AllowPrerelease bool
+
+	// CrossMajor suggests the newest version of any major even when a newer
+	// version of the current major exists.
+	CrossMajor bool
+}
+
+// CheckNewer returns the version an auto-updater should offer instead of
+// current, see CheckNewerWith. Same-major updates are preferred.
+func CheckNewer(current Semver, available List, allowPrerelease bool) (Semver, bool) {
+	return CheckNewerWith(current, available, UpdateOptions{AllowPrerelease: allowPrerelease})
+}
+
+// CheckNewerWith returns the newest version of available with a higher
+// precedence than current. Invalid entries are ignored, prereleases are
+// skipped unless opts.AllowPrerelease is set. Unless opts.CrossMajor is set,
+// the newest version of current's major wins and other majors are only
+// offered when the current major has no update.
+//
+// Returns (zero, false) if current is invalid or there is no update.
+func CheckNewerWith(current Semver, available List, opts UpdateOptions) (Semver, bool) {
+	if !current.Valid {
+		return Semver{}, false
+	}
+
+	var same, newest Semver
+	for _, v := range available {
+		if !v.Valid || !v.IsGreater(current) || (v.HasPre() && !opts.AllowPrerelease) {
+			continue
+		}
+
+		if !newest.Valid || v.IsGreater(newest) {
+			newest = v
+		}
+		if v.Major == current.Major && (!same.Valid || v.IsGreater(same)) {
+			same = v
+		}
+	}
+
+	if same.Valid && !opts.CrossMajor {
+		return same, true
+	}
+
+	return newest, newest.Valid
+}
@@ -0,0 +1,35 @@
+package semver
+
+import "testing"
+
+func TestCheckNewer(t *testing.T) {
+	available := mustList("bad", "1.2.0", "1.3.0", "1.4.0-rc.1", "2.0.0", "2.1.0-beta.1")
+
+	tests := []struct {
+		current string
+		opts    UpdateOptions
+		want    string
+	}{
+		{"1.2.0", UpdateOptions{}, "1.3.0"},
+		{"1.2.0", UpdateOptions{AllowPrerelease: true}, "1.4.0-rc.1"},
+		{"1.2.0", UpdateOptions{CrossMajor: true}, "2.0.0"},
+		{"1.2.0", UpdateOptions{CrossMajor: true, AllowPrerelease: true}, "2.1.0-beta.1"},
+		{"1.4.0", UpdateOptions{}, "2.0.0"},
+		{"1.3.0-rc.1", UpdateOptions{}, "1.3.0"},
+		{"2.0.0", UpdateOptions{}, ""},
+		{"3.0.0", UpdateOptions{AllowPrerelease: true}, ""},
+		{"bad", UpdateOptions{}, ""},
+	}
+
+	for _, tt := range tests {
+		cur, _ := Parse(tt.current)
+		got, ok := CheckNewerWith(cur, available, tt.opts)
+		if ok != (tt.want != "") || got.Original != tt.want {
+			t.Errorf("CheckNewerWith(%q, %+v) = %q, %v; want %q", tt.current, tt.opts, got.Original, ok, tt.want)
+		}
+	}
+
+	if got, ok := CheckNewer(MustParse("1.2.0"), available, true); !ok || got.Original != "1.4.0-rc.1" {
+		t.Errorf("CheckNewer = %q, %v", got.Original, ok)
+	}
+}
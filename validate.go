@@ -0,0 +1,163 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ValidationError is a single problem found by Validate.
+// It wraps ErrInvalidVersion.
+type ValidationError struct {
+	// Component is "version", "major", "minor", "patch", "prerelease" or "build".
+	Component string
+
+	// Reason describes the problem, e.g. "leading zero in identifier 2".
+	Reason string
+
+	// Offset is the byte offset of the problem in the input.
+	Offset int
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	return ErrInvalidVersion.Error() + ": offset " + strconv.Itoa(e.Offset) + ": " + e.Component + ": " + e.Reason
+}
+
+// Unwrap returns ErrInvalidVersion.
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidVersion
+}
+
+// Validate lists every problem in s instead of stopping at the first one,
+// for linters and form validation. It returns nil exactly when Parse(s)
+// succeeds; all errors are *ValidationError values in input order.
+func Validate(s string) []error {
+	var errs []error
+	add := func(off int, component, reason string) {
+		errs = append(errs, &ValidationError{Offset: off, Component: component, Reason: reason})
+	}
+
+	if s == "" {
+		add(0, "version", "empty input")
+		return errs
+	}
+
+	off := 0
+	if s[0] == 'v' || s[0] == 'V' {
+		off = 1
+	}
+
+	// split into core, prerelease and build
+	coreEnd := len(s)
+	if i := strings.IndexAny(s[off:], "-+"); i >= 0 {
+		coreEnd = off + i
+	}
+	preEnd := coreEnd
+	if coreEnd < len(s) && s[coreEnd] == '-' {
+		preEnd = len(s)
+		if i := strings.IndexByte(s[coreEnd:], '+'); i >= 0 {
+			preEnd = coreEnd + i
+		}
+	}
+
+	// core
+	names := [...]string{"major", "minor", "patch"}
+	segments := 0
+	forEachPart(s, off, coreEnd, func(start int, part string) {
+		if segments >= len(names) {
+			if segments == len(names) {
+				add(start-1, "version", "unexpected component after patch")
+			}
+			segments++
+			return
+		}
+
+		name := names[segments]
+		segments++
+		switch {
+		case part == "":
+			add(start, name, "empty")
+		case badByte(part, isDigit) >= 0:
+			i := badByte(part, isDigit)
+			add(start+i, name, "invalid character "+quoteRuneAt(part, i))
+		case len(part) > 1 && part[0] == '0':
+			add(start, name, "leading zero")
+		default:
+			if _, _, ok := parseInt(part, 0); !ok {
+				add(start, name, "overflows int")
+			}
+		}
+	})
+
+	if coreEnd < len(s) && segments < 3 {
+		add(coreEnd, "version", "prerelease and build require MAJOR.MINOR.PATCH")
+	}
+
+	// prerelease
+	if preEnd > coreEnd {
+		idents(s, coreEnd+1, preEnd, "prerelease", true, add)
+	}
+
+	// build
+	if preEnd < len(s) {
+		idents(s, preEnd+1, len(s), "build", false, add)
+	}
+
+	return errs
+}
+
+// idents validates the dot separated identifiers of s[start:end].
+func idents(s string, start, end int, component string, numeric bool, add func(int, string, string)) {
+	n := 0
+	forEachPart(s, start, end, func(off int, part string) {
+		n++
+		id := "identifier " + strconv.Itoa(n)
+		switch {
+		case part == "":
+			add(off, component, "empty "+id)
+		case badByte(part, isIdentChar) >= 0:
+			i := badByte(part, isIdentChar)
+			add(off+i, component, "invalid character "+quoteRuneAt(part, i)+" in "+id)
+		case numeric && isBadNum(part):
+			add(off, component, "leading zero in "+id)
+		}
+	})
+}
+
+// forEachPart calls fn with the offset and text of each '.' separated part
+// of s[start:end]; an empty range yields a single empty part.
+func forEachPart(s string, start, end int, fn func(off int, part string)) {
+	for {
+		i := strings.IndexByte(s[start:end], '.')
+		if i < 0 {
+			fn(start, s[start:end])
+			return
+		}
+
+		fn(start, s[start:start+i])
+		start += i + 1
+	}
+}
+
+// badByte returns the index of the first byte of s rejected by ok, or -1.
+func badByte(s string, ok func(byte) bool) int {
+	for i := 0; i < len(s); i++ {
+		if !ok(s[i]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// quoteRuneAt quotes the UTF-8 character starting at byte i of s.
+func quoteRuneAt(s string, i int) string {
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	return strconv.QuoteRune(r)
+}
@@ -0,0 +1,85 @@
+package semver
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"v1.2.3-rc.1+build.5", nil},
+		{"", []string{"offset 0: version: empty input"}},
+		{"v", []string{"offset 1: major: empty"}},
+		{"01.2.x", []string{
+			"offset 0: major: leading zero",
+			"offset 5: patch: invalid character 'x'",
+		}},
+		{"1.2.3.4", []string{"offset 5: version: unexpected component after patch"}},
+		{"1.2-rc", []string{"offset 3: version: prerelease and build require MAJOR.MINOR.PATCH"}},
+		{"1.2.3-rc..01+a_b.", []string{
+			"offset 9: prerelease: empty identifier 2",
+			"offset 10: prerelease: leading zero in identifier 3",
+			"offset 14: build: invalid character '_' in identifier 1",
+			"offset 17: build: empty identifier 2",
+		}},
+		{"1.2.3 ", []string{"offset 5: patch: invalid character ' '"}},
+		{"1.2.3-é", []string{"offset 6: prerelease: invalid character 'é' in identifier 1"}},
+		{"1.2.é", []string{"offset 4: patch: invalid character 'é'"}},
+		{"99999999999999999999.0.0", []string{"offset 0: major: overflows int"}},
+	}
+
+	for _, tt := range tests {
+		errs := Validate(tt.in)
+
+		var got []string
+		for _, err := range errs {
+			if !errors.Is(err, ErrInvalidVersion) {
+				t.Errorf("Validate(%q): %v does not wrap ErrInvalidVersion", tt.in, err)
+			}
+			got = append(got, strings.TrimPrefix(err.Error(), "semver: invalid version: "))
+		}
+
+		if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+			t.Errorf("Validate(%q) =\n%s\nwant\n%s", tt.in, strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+		}
+	}
+}
+
+// TestValidate_AgreesWithParse checks Validate(s) == nil iff Parse(s) succeeds
+// on mutations of valid versions.
+func TestValidate_AgreesWithParse(t *testing.T) {
+	seeds := []string{"1.2.3", "v0.0.0-rc.1+b", "V10.20", "1.0.0-0.a-b+001", "7"}
+	alphabet := "0123456789.-+vVax_ "
+	rng := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 20000; i++ {
+		b := []byte(seeds[rng.Intn(len(seeds))])
+		for n := rng.Intn(3) + 1; n > 0; n-- {
+			pos := rng.Intn(len(b) + 1)
+			c := alphabet[rng.Intn(len(alphabet))]
+			switch rng.Intn(3) {
+			case 0:
+				b = append(b[:pos], append([]byte{c}, b[pos:]...)...)
+			case 1:
+				if pos < len(b) {
+					b[pos] = c
+				}
+			default:
+				if pos < len(b) {
+					b = append(b[:pos], b[pos+1:]...)
+				}
+			}
+		}
+
+		s := string(b)
+		_, ok := Parse(s)
+		if errs := Validate(s); ok != (len(errs) == 0) {
+			t.Fatalf("Parse(%q) ok=%v but Validate returned %v", s, ok, errs)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package versionfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/woozymasta/semver"
+)
+
+// Manifest is a build manifest format with a version field.
+type Manifest uint8
+
+const (
+	ManifestGradle      Manifest = iota // gradle.properties "version=..."
+	ManifestPackageJSON                 // package.json top-level "version"
+	ManifestCargo                       // Cargo.toml [package] or [workspace.package] version
+)
+
+// ErrNoVersionField reports a manifest without a version field.
+var ErrNoVersionField = errors.New("versionfile: no version field")
+
+// manifestNames are the file names and String forms of Manifest.
+var manifestNames = [...]string{
+	ManifestGradle:      "gradle.properties",
+	ManifestPackageJSON: "package.json",
+	ManifestCargo:       "Cargo.toml",
+}
+
+// String returns the conventional file name of m.
+func (m Manifest) String() string {
+	if int(m) < len(manifestNames) {
+		return manifestNames[m]
+	}
+
+	return "unknown"
+}
+
+// DetectManifest returns the Manifest for the base name of path.
+func DetectManifest(path string) (Manifest, bool) {
+	name := filepath.Base(path)
+	for m, n := range manifestNames {
+		if name == n {
+			return Manifest(m), true
+		}
+	}
+
+	return 0, false
+}
+
+// Field is the location of a version field value in a manifest.
+// data[Offset:End] is Value, without quotes.
+type Field struct {
+	Value  string
+	Offset int
+	End    int
+	Line   int // 1-based
+}
+
+// Change describes a version field rewrite. Old equals New when the
+// field already held the requested version.
+type Change struct {
+	Old      string
+	New      string
+	Offset   int
+	Line     int
+	Manifest Manifest
+}
+
+// Changed reports whether the rewrite modified the manifest.
+func (c Change) Changed() bool {
+	return c.Old != c.New
+}
+
+// Locate finds the version field of manifest data. The value is returned
+// as written and may not be a valid version ("unspecified" in Gradle).
+func Locate(data []byte, m Manifest) (Field, error) {
+	var f Field
+	var err error
+	switch m {
+	case ManifestGradle:
+		f, err = locateGradle(data)
+	case ManifestPackageJSON:
+		f, err = locatePackageJSON(data)
+	case ManifestCargo:
+		f, err = locateCargo(data)
+	default:
+		return Field{}, fmt.Errorf("%w: unknown manifest %d", ErrFormat, m)
+	}
+	if err != nil {
+		return Field{}, err
+	}
+
+	f.Line = 1 + bytes.Count(data[:f.Offset], []byte{'\n'})
+	return f, nil
+}
+
+// SetVersion returns a copy of data with the version field replaced by v
+// rendered without prefix (MAJOR.MINOR.PATCH[-PRE][+BUILD]). Only the
+// value bytes change: quotes, spacing, comments and key order are kept.
+func SetVersion(data []byte, m Manifest, v semver.Semver) ([]byte, Change, error) {
+	if !v.Valid {
+		return nil, Change{}, fmt.Errorf("%w: %q", semver.ErrInvalidVersion, v.Original)
+	}
+
+	f, err := Locate(data, m)
+	if err != nil {
+		return nil, Change{}, err
+	}
+
+	c := Change{Old: f.Value, New: v.SemVer(), Offset: f.Offset, Line: f.Line, Manifest: m}
+
+	out := make([]byte, 0, len(data)-len(c.Old)+len(c.New))
+	out = append(out, data[:f.Offset]...)
+	out = append(out, c.New...)
+	out = append(out, data[f.End:]...)
+
+	return out, c, nil
+}
+
+// EditFile rewrites the version field of the manifest at path, detected
+// with DetectManifest, to v. The file is only written when it changes and
+// keeps its permissions.
+func EditFile(path string, v semver.Semver) (Change, error) {
+	m, ok := DetectManifest(path)
+	if !ok {
+		return Change{}, fmt.Errorf("%w: unknown manifest %q", ErrFormat, filepath.Base(path))
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return Change{}, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Change{}, err
+	}
+
+	out, c, err := SetVersion(data, m, v)
+	if err != nil || !c.Changed() {
+		return c, err
+	}
+
+	return c, os.WriteFile(path, out, info.Mode().Perm())
+}
+
+// locateGradle finds "version=...", "version = ..." or "version: ..." in a
+// Java properties file, skipping '#' and '!' comments.
+func locateGradle(data []byte) (Field, error) {
+	off := 0
+	for _, line := range bytes.SplitAfter(data, []byte{'\n'}) {
+		start := off
+		off += len(line)
+
+		text := bytes.TrimRight(line, " \t\r\n")
+		i := len(text) - len(bytes.TrimLeft(text, " \t"))
+		rest, ok := bytes.CutPrefix(text[i:], []byte("version"))
+		if !ok {
+			continue
+		}
+
+		rest = bytes.TrimLeft(rest, " \t")
+		if len(rest) == 0 || rest[0] != '=' && rest[0] != ':' {
+			continue
+		}
+		rest = bytes.TrimLeft(rest[1:], " \t")
+
+		return Field{Value: string(rest), Offset: start + len(text) - len(rest), End: start + len(text)}, nil
+	}
+
+	return Field{}, fmt.Errorf("%w in %s", ErrNoVersionField, ManifestGradle)
+}
+
+// locatePackageJSON finds the string value of the top-level "version" key.
+func locatePackageJSON(data []byte) (Field, error) {
+	if t := bytes.TrimSpace(data); len(t) == 0 || t[0] != '{' {
+		return Field{}, fmt.Errorf("%w: %s is not a JSON object", ErrFormat, ManifestPackageJSON)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	depth, key, isVersion := 0, false, false
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Field{}, fmt.Errorf("%w: %s", ErrFormat, err.Error())
+		}
+
+		if d, ok := tok.(json.Delim); ok {
+			if d == '{' || d == '[' {
+				depth++
+			} else {
+				depth--
+			}
+			key = depth == 1
+			continue
+		}
+		if depth != 1 {
+			continue
+		}
+		if key {
+			isVersion, key = tok == "version", false
+			continue
+		}
+		key = true
+		if !isVersion {
+			continue
+		}
+
+		s, ok := tok.(string)
+		end := int(dec.InputOffset()) - 1
+		if !ok || end < len(s) || string(data[end-len(s):end]) != s {
+			return Field{}, fmt.Errorf("%w: %s \"version\" is not a plain string", ErrFormat, ManifestPackageJSON)
+		}
+
+		return Field{Value: s, Offset: end - len(s), End: end}, nil
+	}
+
+	return Field{}, fmt.Errorf("%w in %s", ErrNoVersionField, ManifestPackageJSON)
+}
+
+// locateCargo finds the quoted version of the [package] or
+// [workspace.package] table.
+func locateCargo(data []byte) (Field, error) {
+	off, table := 0, ""
+	for _, line := range bytes.SplitAfter(data, []byte{'\n'}) {
+		start := off
+		off += len(line)
+
+		text := strings.TrimSpace(string(line))
+		if strings.HasPrefix(text, "[") {
+			name, _, _ := strings.Cut(text[1:], "]")
+			table = strings.TrimSpace(name)
+			continue
+		}
+		if table != "package" && table != "workspace.package" {
+			continue
+		}
+
+		key, val, ok := strings.Cut(text, "=")
+		if !ok || strings.TrimSpace(key) != "version" {
+			continue
+		}
+		val = strings.TrimSpace(val)
+		if len(val) < 2 || val[0] != '"' && val[0] != '\'' {
+			continue
+		}
+		q := val[0]
+		n := strings.IndexByte(val[1:], q)
+		if n < 0 {
+			return Field{}, fmt.Errorf("%w: unterminated version in %s", ErrFormat, ManifestCargo)
+		}
+
+		i := start + bytes.Index(line, []byte(val)) + 1
+		return Field{Value: val[1 : 1+n], Offset: i, End: i + n}, nil
+	}
+
+	return Field{}, fmt.Errorf("%w in %s", ErrNoVersionField, ManifestCargo)
+}
@@ -0,0 +1,85 @@
+package versionfile
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/woozymasta/semver"
+)
+
+func TestSetVersion(t *testing.T) {
+	v := semver.MustParse("v2.0.0-rc.1")
+
+	tests := []struct {
+		in       string
+		want     string // "" if the field is not found
+		old      string
+		line     int
+		manifest Manifest
+	}{
+		{
+			"# build\nversionCode=7\nversion = 1.4.0 \ngroup=org.example\n",
+			"# build\nversionCode=7\nversion = 2.0.0-rc.1 \ngroup=org.example\n",
+			"1.4.0", 3, ManifestGradle,
+		},
+		{"version:unspecified", "version:2.0.0-rc.1", "unspecified", 1, ManifestGradle},
+		{"group=org.example\n", "", "", 0, ManifestGradle},
+
+		{
+			"{\n  \"name\": \"app\",\n  \"dependencies\": {\"version\": \"9.9.9\"},\n  \"version\":  \"1.4.0\",\n  \"private\": true\n}\n",
+			"{\n  \"name\": \"app\",\n  \"dependencies\": {\"version\": \"9.9.9\"},\n  \"version\":  \"2.0.0-rc.1\",\n  \"private\": true\n}\n",
+			"1.4.0", 4, ManifestPackageJSON,
+		},
+		{`{"config": [{"version": "1.0.0"}]}`, "", "", 0, ManifestPackageJSON},
+
+		{
+			"[dependencies]\nserde = { version = \"1.0\" }\n\n[package] # main\nname = \"app\"\nversion = \"1.4.0\" # bumped by CI\n",
+			"[dependencies]\nserde = { version = \"1.0\" }\n\n[package] # main\nname = \"app\"\nversion = \"2.0.0-rc.1\" # bumped by CI\n",
+			"1.4.0", 6, ManifestCargo,
+		},
+		{"[workspace.package]\nversion='1.4.0'\n", "[workspace.package]\nversion='2.0.0-rc.1'\n", "1.4.0", 2, ManifestCargo},
+		{"[package]\nversion.workspace = true\n", "", "", 0, ManifestCargo},
+	}
+
+	for _, tt := range tests {
+		out, c, err := SetVersion([]byte(tt.in), tt.manifest, v)
+		if tt.want == "" {
+			if !errors.Is(err, ErrNoVersionField) {
+				t.Errorf("SetVersion(%q, %v) = %v, want ErrNoVersionField", tt.in, tt.manifest, err)
+			}
+			continue
+		}
+		if err != nil || string(out) != tt.want {
+			t.Errorf("SetVersion(%q, %v) = %q, %v, want %q", tt.in, tt.manifest, out, err, tt.want)
+			continue
+		}
+		if c.Old != tt.old || c.New != "2.0.0-rc.1" || c.Line != tt.line || !c.Changed() {
+			t.Errorf("SetVersion(%q, %v) change = %+v", tt.in, tt.manifest, c)
+		}
+	}
+}
+
+func TestEditFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "package.json")
+	if err := os.WriteFile(path, []byte(`{"version": "1.0.0"}`), 0o640); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := EditFile(path, semver.MustParse("1.0.1"))
+	if err != nil || c.Old != "1.0.0" || c.New != "1.0.1" {
+		t.Fatalf("EditFile = %+v, %v", c, err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != `{"version": "1.0.1"}` {
+		t.Errorf("file = %s", data)
+	}
+
+	if c, err := EditFile(path, semver.MustParse("1.0.1")); err != nil || c.Changed() {
+		t.Errorf("EditFile unchanged = %+v, %v", c, err)
+	}
+
+	if _, err := EditFile(filepath.Join(t.TempDir(), "pom.xml"), semver.MustParse("1.0.1")); !errors.Is(err, ErrFormat) {
+		t.Errorf("EditFile(pom.xml) = %v, want ErrFormat", err)
+	}
+}
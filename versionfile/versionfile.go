@@ -0,0 +1,267 @@
+/*
+Package versionfile reads and writes version pinning files kept next to
+the sources, so release tooling round-trips them with validation instead
+of ad-hoc string edits.
+
+Supported formats:
+
+	FormatPlain       VERSION      "1.2.3" on the first non-comment line
+	FormatSemverYAML  .semver      ":major: 1" ... (Ruby semver gem layout)
+	FormatJSON        version.json {"version": "1.2.3"} or "1.2.3"
+
+Load picks the format from the file name and falls back to sniffing the
+content like Read does.
+
+The version field of build manifests (gradle.properties, package.json,
+Cargo.toml) is edited in place instead: SetVersion and EditFile replace
+only the value bytes and report the Change, keeping formatting intact.
+*/
+package versionfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/woozymasta/semver"
+)
+
+// Format is a version file layout.
+type Format uint8
+
+const (
+	FormatPlain      Format = iota // single version line
+	FormatSemverYAML               // classic .semver YAML
+	FormatJSON                     // JSON object with a "version" field
+)
+
+// ErrFormat reports a malformed version file or an unknown Format.
+var ErrFormat = errors.New("versionfile: malformed version file")
+
+// String returns the lowercase name of f.
+func (f Format) String() string {
+	switch f {
+	case FormatPlain:
+		return "plain"
+	case FormatSemverYAML:
+		return "semver-yaml"
+	case FormatJSON:
+		return "json"
+	}
+
+	return "unknown"
+}
+
+// Load reads the version file at path. Files named ".semver" are read as
+// FormatSemverYAML and "*.json" as FormatJSON; anything else is sniffed,
+// see Read.
+func Load(path string) (semver.Semver, Format, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return semver.Semver{}, 0, err
+	}
+
+	switch name := filepath.Base(path); {
+	case name == ".semver":
+		v, err := decodeSemverYAML(data)
+		return v, FormatSemverYAML, err
+	case strings.EqualFold(filepath.Ext(name), ".json"):
+		v, err := decodeJSON(data)
+		return v, FormatJSON, err
+	}
+
+	return decode(data)
+}
+
+// Read reads a version file from r, detecting the format from its content:
+// JSON starts with '{' or '"', .semver YAML has a ":major:" key, anything
+// else is FormatPlain.
+func Read(r io.Reader) (semver.Semver, Format, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return semver.Semver{}, 0, err
+	}
+
+	return decode(data)
+}
+
+// Save writes v to w in format f. FormatPlain writes Original (or String
+// for constructed versions) followed by a newline, so a loaded file saves
+// byte for byte. Invalid versions fail with semver.ErrInvalidVersion.
+// Version files hold SemVer only, so versions with an epoch or revision
+// (semver.ParseWith) fail with ErrFormat in every format, as Load would
+// reject them.
+func Save(w io.Writer, v semver.Semver, f Format) error {
+	if !v.Valid {
+		return fmt.Errorf("%w: %q", semver.ErrInvalidVersion, v.Original)
+	}
+
+	text := v.Original
+	if text == "" {
+		text = v.String()
+	}
+	if v.HasEpoch() || v.HasRevision() {
+		return fmt.Errorf("%w: %q is not a SemVer version", ErrFormat, text)
+	}
+
+	var out []byte
+	switch f {
+	case FormatPlain:
+		out = []byte(text + "\n")
+
+	case FormatSemverYAML:
+		out = fmt.Appendf(nil, "---\n:major: %d\n:minor: %d\n:patch: %d\n:special: %s\n:metadata: %s\n",
+			v.Major, v.Minor, v.Patch, yamlQuote(v.Prerelease), yamlQuote(v.Build))
+
+	case FormatJSON:
+		b, err := json.MarshalIndent(struct {
+			Version string `json:"version"`
+		}{text}, "", "  ")
+		if err != nil {
+			return err
+		}
+		out = append(b, '\n')
+
+	default:
+		return fmt.Errorf("%w: unknown format %d", ErrFormat, f)
+	}
+
+	_, err := w.Write(out)
+	return err
+}
+
+// decode sniffs the format of data and decodes it.
+func decode(data []byte) (semver.Semver, Format, error) {
+	trimmed := bytes.TrimSpace(data)
+
+	switch {
+	case len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '"'):
+		v, err := decodeJSON(data)
+		return v, FormatJSON, err
+	case bytes.Contains(data, []byte(":major:")):
+		v, err := decodeSemverYAML(data)
+		return v, FormatSemverYAML, err
+	}
+
+	v, err := decodePlain(data)
+	return v, FormatPlain, err
+}
+
+// decodePlain parses the first line that is neither blank nor a '#' comment.
+func decodePlain(data []byte) (semver.Semver, error) {
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		return parse(line)
+	}
+	if err := sc.Err(); err != nil {
+		return semver.Semver{}, err
+	}
+
+	return semver.Semver{}, fmt.Errorf("%w: no version line", ErrFormat)
+}
+
+// decodeJSON parses {"version": "..."} or a bare JSON string.
+func decodeJSON(data []byte) (semver.Semver, error) {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return parse(s)
+	}
+
+	var doc struct {
+		Version *string `json:"version"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return semver.Semver{}, fmt.Errorf("%w: %s", ErrFormat, err.Error())
+	}
+	if doc.Version == nil {
+		return semver.Semver{}, fmt.Errorf("%w: no \"version\" field", ErrFormat)
+	}
+
+	return parse(*doc.Version)
+}
+
+// decodeSemverYAML parses the .semver layout: ":major:", ":minor:" and
+// ":patch:" numbers with optional ":special:" (prerelease) and
+// ":metadata:" (build) strings. Other keys are ignored.
+func decodeSemverYAML(data []byte) (semver.Semver, error) {
+	fields := make(map[string]string)
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || line == "---" || line[0] == '#' {
+			continue
+		}
+
+		key, val, ok := strings.Cut(strings.TrimPrefix(line, ":"), ":")
+		if !ok {
+			return semver.Semver{}, fmt.Errorf("%w: %q", ErrFormat, line)
+		}
+		fields[strings.TrimSpace(key)] = yamlUnquote(strings.TrimSpace(val))
+	}
+	if err := sc.Err(); err != nil {
+		return semver.Semver{}, err
+	}
+
+	var b strings.Builder
+	for i, key := range [...]string{"major", "minor", "patch"} {
+		n, ok := fields[key]
+		if _, err := strconv.Atoi(n); !ok || err != nil {
+			return semver.Semver{}, fmt.Errorf("%w: bad or missing %q", ErrFormat, ":"+key)
+		}
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(n)
+	}
+	if pre := fields["special"]; pre != "" {
+		b.WriteString("-" + pre)
+	}
+	if build := fields["metadata"]; build != "" {
+		b.WriteString("+" + build)
+	}
+
+	return parse(b.String())
+}
+
+// parse parses s and wraps semver.ErrInvalidVersion on failure.
+func parse(s string) (semver.Semver, error) {
+	v, ok := semver.Parse(s)
+	if !ok {
+		return v, fmt.Errorf("%w: %q", semver.ErrInvalidVersion, s)
+	}
+
+	return v, nil
+}
+
+// yamlQuote renders s as a single-quoted YAML scalar.
+func yamlQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// yamlUnquote strips single or double quotes from a YAML scalar.
+func yamlUnquote(s string) string {
+	if len(s) >= 2 {
+		switch {
+		case s[0] == '\'' && s[len(s)-1] == '\'':
+			return strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+		case s[0] == '"' && s[len(s)-1] == '"':
+			if u, err := strconv.Unquote(s); err == nil {
+				return u
+			}
+		}
+	}
+
+	return s
+}
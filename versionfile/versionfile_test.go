@@ -0,0 +1,136 @@
+package versionfile
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/woozymasta/semver"
+)
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   string // Original, "" for an error
+		format Format
+	}{
+		{"1.2.3\n", "1.2.3", FormatPlain},
+		{"# pinned\n\n  v1.2.3-rc.1 \n", "v1.2.3-rc.1", FormatPlain},
+		{"---\n:major: 1\n:minor: 2\n:patch: 3\n:special: ''\n:metadata: ''\n", "1.2.3", FormatSemverYAML},
+		{"---\n:major: 1\n:minor: 0\n:patch: 0\n:special: 'beta.2'\n:metadata: \"build.7\"\n", "1.0.0-beta.2+build.7", FormatSemverYAML},
+		{`{"name": "app", "version": "2.0.0"}`, "2.0.0", FormatJSON},
+		{`"v3.1.4"`, "v3.1.4", FormatJSON},
+
+		{"", "", FormatPlain},
+		{"not-a-version\n", "", FormatPlain},
+		{"---\n:major: 1\n:minor: x\n:patch: 0\n", "", FormatSemverYAML},
+		{"---\n:major: 1\n:minor: 0\n", "", FormatSemverYAML},
+		{`{"name": "app"}`, "", FormatJSON},
+		{`{"version": 1}`, "", FormatJSON},
+	}
+
+	for _, tt := range tests {
+		v, f, err := Read(strings.NewReader(tt.in))
+		if f != tt.format {
+			t.Errorf("Read(%q) format = %v, want %v", tt.in, f, tt.format)
+		}
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("Read(%q) = %q, want error", tt.in, v.Original)
+			}
+			continue
+		}
+		if err != nil || v.Original != tt.want {
+			t.Errorf("Read(%q) = %q, %v, want %q", tt.in, v.Original, err, tt.want)
+		}
+	}
+}
+
+func TestSaveRoundTrip(t *testing.T) {
+	for _, s := range []string{"1.2.3", "v1.2.3-rc.1+build.5", "0.0.1-it's"} {
+		v, ok := semver.Parse(s)
+		if !ok && s != "0.0.1-it's" {
+			t.Fatalf("Parse(%q) failed", s)
+		}
+		for _, f := range []Format{FormatPlain, FormatSemverYAML, FormatJSON} {
+			var b strings.Builder
+			err := Save(&b, v, f)
+			if !ok {
+				if !errors.Is(err, semver.ErrInvalidVersion) {
+					t.Errorf("Save(%q, %v) = %v, want ErrInvalidVersion", s, f, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("Save(%q, %v): %v", s, f, err)
+			}
+
+			got, gf, err := Read(strings.NewReader(b.String()))
+			if err != nil || gf != f || !got.IsEqual(v) || got.Build != v.Build {
+				t.Errorf("Save(%q, %v) = %q, read back %q, %v, %v", s, f, b.String(), got.Original, gf, err)
+			}
+		}
+	}
+}
+
+func TestSaveFormats(t *testing.T) {
+	v := semver.MustParse("v1.2.3-rc.1")
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{FormatPlain, "v1.2.3-rc.1\n"},
+		{FormatSemverYAML, "---\n:major: 1\n:minor: 2\n:patch: 3\n:special: 'rc.1'\n:metadata: ''\n"},
+		{FormatJSON, "{\n  \"version\": \"v1.2.3-rc.1\"\n}\n"},
+	}
+	for _, tt := range tests {
+		var b strings.Builder
+		if err := Save(&b, v, tt.format); err != nil || b.String() != tt.want {
+			t.Errorf("Save(%v) = %q, %v, want %q", tt.format, b.String(), err, tt.want)
+		}
+	}
+
+	var b strings.Builder
+	if err := Save(&b, v, Format(9)); !errors.Is(err, ErrFormat) {
+		t.Errorf("Save(unknown) = %v, want ErrFormat", err)
+	}
+
+	opts := semver.ParseOptions{AllowEpoch: true, AllowRevision: true}
+	for _, s := range []string{"2:1.4.0", "1.2.3.4", "1.2.3.0"} {
+		v, _ := semver.ParseWith(s, opts)
+		for _, f := range []Format{FormatPlain, FormatSemverYAML, FormatJSON} {
+			var b strings.Builder
+			if err := Save(&b, v, f); !errors.Is(err, ErrFormat) || b.Len() != 0 {
+				t.Errorf("Save(%q, %v) = %q, %v, want ErrFormat", s, f, b.String(), err)
+			}
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		".semver":      "---\n:major: 4\n:minor: 5\n:patch: 6\n:special: ''\n:metadata: ''\n",
+		"version.json": `{"version": "4.5.6"}`,
+		"VERSION":      "4.5.6\n",
+	}
+	formats := map[string]Format{".semver": FormatSemverYAML, "version.json": FormatJSON, "VERSION": FormatPlain}
+
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		v, f, err := Load(path)
+		if err != nil || f != formats[name] || v.Canonical() != "v4.5.6" {
+			t.Errorf("Load(%s) = %q, %v, %v", name, v.Original, f, err)
+		}
+	}
+
+	if _, _, err := Load(filepath.Join(dir, "missing")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Load(missing) = %v, want ErrNotExist", err)
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Package versionhttp serves component versions over HTTP, as the /version
+endpoint of a service:
+
+	http.Handle("/version", versionhttp.Handler{})
+
+The zero Handler serves the process-wide registry (semver.RegisterComponent);
+Static serves a fixed semver.VersionMap. Responses are JSON with the
+original, canonical and structured (semver.Semver.ToMap) form of each
+version:
+
+	{
+	  "components": {
+	    "app": {
+	      "version": "v1.4.2",
+	      "canonical": "v1.4.2",
+	      "structured": {"major": 1, "minor": 4, "patch": 2, ...},
+	      "satisfies": true
+	    }
+	  },
+	  "satisfied": true
+	}
+
+Query parameters:
+
+	component=NAME    only report NAME (repeatable, 404 if unknown)
+	satisfies=RANGE   check every reported version against a constraint
+
+Constraints are checked with semver.CheckOptions.IncludePrerelease.
+With satisfies, "satisfies" and "satisfied" are set and the status is
+412 Precondition Failed unless all versions satisfy it, so health checks
+and deploy gates can rely on the status code alone.
+*/
+package versionhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	"github.com/woozymasta/semver"
+)
+
+// Handler is an http.Handler serving component versions.
+type Handler struct {
+	// Versions returns the versions to serve on each request;
+	// nil means semver.ComponentVersions.
+	Versions func() semver.VersionMap
+}
+
+// Component is the JSON form of one component version.
+type Component struct {
+	Satisfies  *bool          `json:"satisfies,omitempty"`
+	Structured map[string]any `json:"structured"`
+	Version    string         `json:"version"`
+	Canonical  string         `json:"canonical"`
+}
+
+// Response is the JSON document served by Handler.
+type Response struct {
+	Components map[string]Component `json:"components"`
+	Satisfied  *bool                `json:"satisfied,omitempty"`
+	Error      string               `json:"error,omitempty"`
+}
+
+// Static returns a Handler serving m. The map is used as is, so later
+// changes by the caller show up in responses; pass a copy to freeze it.
+func Static(m semver.VersionMap) Handler {
+	return Handler{Versions: func() semver.VersionMap { return m }}
+}
+
+// ServeHTTP implements http.Handler for GET and HEAD requests.
+func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeJSON(w, http.StatusMethodNotAllowed, Response{Error: "method not allowed"})
+		return
+	}
+
+	versions := h.Versions
+	if versions == nil {
+		versions = semver.ComponentVersions
+	}
+
+	query := r.URL.Query()
+	var check *semver.Constraint
+	if expr, ok := query["satisfies"]; ok {
+		c, ok := semver.ParseConstraint(expr[0])
+		if !ok || len(expr) > 1 {
+			writeJSON(w, http.StatusBadRequest, Response{Error: "invalid satisfies constraint"})
+			return
+		}
+		check = &c
+	}
+	only := query["component"]
+
+	resp := Response{Components: make(map[string]Component)}
+	if check != nil {
+		resp.Satisfied = new(bool)
+		*resp.Satisfied = true
+	}
+
+	for name, v := range versions() {
+		if len(only) > 0 && !slices.Contains(only, name) {
+			continue
+		}
+
+		c := Component{Version: v.Original, Canonical: v.Canonical(), Structured: v.ToMap()}
+		if c.Version == "" {
+			c.Version = v.String()
+		}
+		if check != nil {
+			ok := check.CheckWith(v, semver.CheckOptions{IncludePrerelease: true})
+			c.Satisfies = &ok
+			*resp.Satisfied = *resp.Satisfied && ok
+		}
+		resp.Components[name] = c
+	}
+
+	for _, name := range only {
+		if _, ok := resp.Components[name]; !ok {
+			writeJSON(w, http.StatusNotFound, Response{Error: "unknown component " + name})
+			return
+		}
+	}
+
+	status := http.StatusOK
+	if resp.Satisfied != nil && !*resp.Satisfied {
+		status = http.StatusPreconditionFailed
+	}
+	writeJSON(w, status, resp)
+}
+
+// writeJSON writes resp with status.
+func writeJSON(w http.ResponseWriter, status int, resp Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(resp)
+}
@@ -0,0 +1,88 @@
+package versionhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/woozymasta/semver"
+)
+
+// serve runs one request against h and decodes the response.
+func serve(t *testing.T, h http.Handler, method, target string) (int, Response) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+
+	var resp Response
+	if method != http.MethodHead {
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("%s %s: %v: %s", method, target, err, rec.Body.String())
+		}
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+
+	return rec.Code, resp
+}
+
+func TestHandler(t *testing.T) {
+	h := Static(semver.VersionMap{
+		"app":    semver.MustParse("1.4.2"),
+		"plugin": semver.MustParse("v2.0.0-rc.1+b.3"),
+	})
+
+	code, resp := serve(t, h, http.MethodGet, "/version")
+	if code != http.StatusOK || len(resp.Components) != 2 || resp.Satisfied != nil {
+		t.Fatalf("GET = %d, %+v", code, resp)
+	}
+	p := resp.Components["plugin"]
+	if p.Version != "v2.0.0-rc.1+b.3" || p.Canonical != "v2.0.0-rc.1" || p.Satisfies != nil {
+		t.Errorf("plugin = %+v", p)
+	}
+	if p.Structured["major"] != 2.0 || len(p.Structured["prerelease"].([]any)) != 2 {
+		t.Errorf("plugin structured = %v", p.Structured)
+	}
+
+	tests := []struct {
+		target    string
+		code      int
+		satisfied bool
+		n         int
+	}{
+		{"/version?satisfies=^1.2", http.StatusPreconditionFailed, false, 2},
+		{"/version?satisfies=^1.2&component=app", http.StatusOK, true, 1},
+		{"/version?satisfies=>=1.0.0&component=app&component=plugin", http.StatusOK, true, 2},
+	}
+	for _, tt := range tests {
+		code, resp := serve(t, h, http.MethodGet, tt.target)
+		if code != tt.code || resp.Satisfied == nil || *resp.Satisfied != tt.satisfied || len(resp.Components) != tt.n {
+			t.Errorf("GET %s = %d, %+v", tt.target, code, resp)
+		}
+	}
+
+	if code, resp := serve(t, h, http.MethodGet, "/version?satisfies=%3E%3Dbad"); code != http.StatusBadRequest || resp.Error == "" {
+		t.Errorf("bad constraint = %d, %+v", code, resp)
+	}
+	if code, resp := serve(t, h, http.MethodGet, "/version?component=nope"); code != http.StatusNotFound || resp.Error == "" {
+		t.Errorf("unknown component = %d, %+v", code, resp)
+	}
+	if code, _ := serve(t, h, http.MethodPost, "/version"); code != http.StatusMethodNotAllowed {
+		t.Errorf("POST = %d", code)
+	}
+	if code, _ := serve(t, h, http.MethodHead, "/version"); code != http.StatusOK {
+		t.Errorf("HEAD = %d", code)
+	}
+}
+
+func TestHandler_Registry(t *testing.T) {
+	semver.MustRegisterComponent("versionhttp-test", "0.9.0")
+
+	code, resp := serve(t, Handler{}, http.MethodGet, "/version?component=versionhttp-test")
+	if code != http.StatusOK || resp.Components["versionhttp-test"].Canonical != "v0.9.0" {
+		t.Errorf("GET = %d, %+v", code, resp)
+	}
+}
@@ -0,0 +1,166 @@
+package semver
+
+import (
+	"fmt"
+	"slices"
+)
+
+// DiffLevel is the most significant component that differs between two
+// versions, see Diff. Levels are ordered, so "at least a minor change" is
+// level >= DiffMinor.
+type DiffLevel uint8
+
+const (
+	DiffNone       DiffLevel = iota // identical, build metadata included
+	DiffBuild                       // build metadata only
+	DiffPrerelease                  // prerelease only
+	DiffPatch                       // PATCH (or revision)
+	DiffMinor                       // MINOR
+	DiffMajor                       // MAJOR or epoch, or validity
+)
+
+// diffLevelNames are the String forms of DiffLevel.
+var diffLevelNames = [...]string{
+	DiffNone:       "none",
+	DiffBuild:      "build",
+	DiffPrerelease: "prerelease",
+	DiffPatch:      "patch",
+	DiffMinor:      "minor",
+	DiffMajor:      "major",
+}
+
+// String returns the lowercase name of l.
+func (l DiffLevel) String() string {
+	if int(l) < len(diffLevelNames) {
+		return diffLevelNames[l]
+	}
+
+	return "unknown"
+}
+
+// Diff returns the most significant component that differs between a and
+// b. A change in validity counts as DiffMajor; two invalid versions are
+// DiffNone.
+func Diff(a, b Semver) DiffLevel {
+	switch {
+	case !a.Valid && !b.Valid:
+		return DiffNone
+	case a.Valid != b.Valid, a.Epoch != b.Epoch, a.Major != b.Major:
+		return DiffMajor
+	case a.Minor != b.Minor:
+		return DiffMinor
+	case a.Patch != b.Patch, a.Revision != b.Revision:
+		return DiffPatch
+	case a.Prerelease != b.Prerelease:
+		return DiffPrerelease
+	case a.Build != b.Build:
+		return DiffBuild
+	}
+
+	return DiffNone
+}
+
+// VersionMap maps module (package, component) names of a monorepo to their
+// versions, with the bulk operations release orchestration needs.
+type VersionMap map[string]Semver
+
+// ModuleChange is one entry of VersionMap.Diff. From is invalid for an
+// added module and To for a removed one.
+type ModuleChange struct {
+	Name  string
+	From  Semver
+	To    Semver
+	Level DiffLevel
+}
+
+// Names returns the module names in sorted order.
+func (m VersionMap) Names() []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// BumpAll returns a new map with every version bumped by kind, see
+// Semver.Bump. It fails with an error wrapping ErrInvalidVersion naming
+// the first module (in name order) that can not be bumped.
+func (m VersionMap) BumpAll(kind BumpKind) (VersionMap, error) {
+	out := make(VersionMap, len(m))
+	for _, name := range m.Names() {
+		v, ok := m[name].Bump(kind)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s %q", ErrInvalidVersion, name, m[name].Original)
+		}
+		out[name] = v
+	}
+
+	return out, nil
+}
+
+// MaxOf returns the highest version among the named modules, or among all
+// modules when names is empty. Unknown names and invalid versions are
+// skipped; ties go to the first name in sorted order. Returns false if no
+// valid version was found.
+func (m VersionMap) MaxOf(names ...string) (Semver, bool) {
+	if len(names) == 0 {
+		names = m.Names()
+	}
+
+	var best Semver
+	for _, name := range names {
+		if v, ok := m[name]; ok && v.Valid && (!best.Valid || v.IsGreater(best)) {
+			best = v
+		}
+	}
+
+	return best, best.Valid
+}
+
+// ValidateConsistent checks that every version is valid and all share one
+// MAJOR, as lockstep-versioned monorepos require. It fails with an error
+// wrapping ErrInvalidVersion or ErrInconsistent naming the first offending
+// module in name order. An empty map is consistent.
+func (m VersionMap) ValidateConsistent() error {
+	names := m.Names()
+	for _, name := range names {
+		if v := m[name]; !v.Valid {
+			return fmt.Errorf("%w: %s %q", ErrInvalidVersion, name, v.Original)
+		}
+	}
+
+	for _, name := range names {
+		first, v := m[names[0]], m[name]
+		if v.Major != first.Major {
+			return fmt.Errorf("%w: %s is at major %d, %s at %d",
+				ErrInconsistent, name, v.Major, names[0], first.Major)
+		}
+	}
+
+	return nil
+}
+
+// Diff returns the modules whose version differs between m and other
+// (m being the old state), including added and removed modules, sorted by
+// name.
+func (m VersionMap) Diff(other VersionMap) []ModuleChange {
+	names := m.Names()
+	for name := range other {
+		if _, ok := m[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var out []ModuleChange
+	for _, name := range names {
+		from, to := m[name], other[name]
+		if l := Diff(from, to); l != DiffNone {
+			out = append(out, ModuleChange{Name: name, From: from, To: to, Level: l})
+		}
+	}
+
+	return out
+}
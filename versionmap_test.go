@@ -0,0 +1,103 @@
+package semver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want DiffLevel
+	}{
+		{"1.2.3", "1.2.3", DiffNone},
+		{"1.2.3", "v1.2.3", DiffNone},
+		{"1.2.3+a", "1.2.3+b", DiffBuild},
+		{"1.2.3-rc.1", "1.2.3", DiffPrerelease},
+		{"1.2.3", "1.2.4", DiffPatch},
+		{"1.2.3", "1.3.0", DiffMinor},
+		{"1.2.3", "2.0.0", DiffMajor},
+		{"1.2.3", "bogus", DiffMajor},
+		{"bogus", "junk", DiffNone},
+	}
+
+	for _, tt := range tests {
+		a, _ := Parse(tt.a)
+		b, _ := Parse(tt.b)
+		if got := Diff(a, b); got != tt.want {
+			t.Errorf("Diff(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestVersionMap(t *testing.T) {
+	m := VersionMap{
+		"api":    MustParse("v1.4.0"),
+		"cli":    MustParse("v1.2.9"),
+		"worker": MustParse("v1.4.0+build.2"),
+	}
+
+	bumped, err := m.BumpAll(BumpKindMinor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{"api": "v1.5.0", "cli": "v1.3.0", "worker": "v1.5.0"} {
+		v := bumped[name]
+		if got := v.Canonical(); got != want {
+			t.Errorf("BumpAll[%s] = %s, want %s", name, got, want)
+		}
+	}
+	if api := m["api"]; api.Canonical() != "v1.4.0" {
+		t.Error("BumpAll modified the receiver")
+	}
+
+	if v, ok := m.MaxOf(); !ok || v.Original != "v1.4.0" {
+		t.Errorf("MaxOf() = %q, %v, want first of the tie", v.Original, ok)
+	}
+	if v, ok := m.MaxOf("cli", "missing"); !ok || v.Original != "v1.2.9" {
+		t.Errorf("MaxOf(cli) = %q, %v", v.Original, ok)
+	}
+	if _, ok := m.MaxOf("missing"); ok {
+		t.Error("MaxOf(missing) ok")
+	}
+
+	if err := m.ValidateConsistent(); err != nil {
+		t.Errorf("ValidateConsistent = %v", err)
+	}
+	m["db"] = MustParse("v2.0.0")
+	if err := m.ValidateConsistent(); !errors.Is(err, ErrInconsistent) {
+		t.Errorf("ValidateConsistent = %v, want ErrInconsistent", err)
+	}
+	m["db"] = Semver{Original: "bogus"}
+	if err := m.ValidateConsistent(); !errors.Is(err, ErrInvalidVersion) {
+		t.Errorf("ValidateConsistent = %v, want ErrInvalidVersion", err)
+	}
+	if _, err := m.BumpAll(BumpKindPatch); !errors.Is(err, ErrInvalidVersion) {
+		t.Errorf("BumpAll = %v, want ErrInvalidVersion", err)
+	}
+	delete(m, "db")
+
+	next := VersionMap{
+		"api":    MustParse("v2.0.0"),
+		"cli":    MustParse("v1.2.9"),
+		"worker": MustParse("v1.4.1"),
+		"web":    MustParse("v0.1.0"),
+	}
+	delete(m, "cli")
+	m["legacy"] = MustParse("v0.9.0")
+
+	var got []string
+	for _, c := range m.Diff(next) {
+		got = append(got, c.Name+":"+c.Level.String())
+	}
+	want := []string{"api:major", "cli:major", "legacy:major", "web:major", "worker:patch"}
+	if len(got) != len(want) {
+		t.Fatalf("Diff = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Diff = %v, want %v", got, want)
+			break
+		}
+	}
+}
@@ -0,0 +1,8 @@
+module github.com/woozymasta/semver/yamlsemver
+
+go 1.21
+
+require (
+	github.com/woozymasta/semver v0.0.0-20261016014515-e9129fcf30a8
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,114 @@
+/*
+Package yamlsemver provides gopkg.in/yaml.v3 support for semver.Semver and
+semver.Constraint.
+
+It is a separate module so the root semver module stays free of the yaml.v3
+dependency. Use Version and Constraint as field types, so version fields
+decode directly:
+
+	type chart struct {
+		Version     yamlsemver.Version    `yaml:"version"`
+		KubeVersion yamlsemver.Constraint `yaml:"kubeVersion"`
+	}
+
+or decode nodes with DecodeVersion and DecodeConstraint in custom
+unmarshalers. Decoding errors wrap semver.ErrInvalidVersion or
+semver.ErrInvalidConstraint and carry the node position.
+*/
+package yamlsemver
+
+import (
+	"fmt"
+
+	"github.com/woozymasta/semver"
+	"gopkg.in/yaml.v3"
+)
+
+// Version is a semver.Semver with YAML marshaling.
+type Version struct {
+	semver.Semver
+}
+
+// Constraint is a semver.Constraint with YAML marshaling.
+type Constraint struct {
+	semver.Constraint
+}
+
+// MarshalYAML implements yaml.Marshaler. Valid versions are encoded as their
+// Original string (String for constructed or compacted versions), invalid
+// ones as null.
+func (v Version) MarshalYAML() (any, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	if v.Original == "" {
+		return v.String(), nil
+	}
+
+	return v.Original, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, see DecodeVersion.
+func (v *Version) UnmarshalYAML(node *yaml.Node) error {
+	nv, err := DecodeVersion(node)
+	if err != nil {
+		return err
+	}
+
+	v.Semver = nv
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding c as its source string.
+func (c Constraint) MarshalYAML() (any, error) {
+	return c.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, see DecodeConstraint.
+func (c *Constraint) UnmarshalYAML(node *yaml.Node) error {
+	nc, err := DecodeConstraint(node)
+	if err != nil {
+		return err
+	}
+
+	c.Constraint = nc
+	return nil
+}
+
+// DecodeVersion parses a version from a scalar node; unquoted numbers such
+// as `version: 1.2` are accepted as written. The epoch and revision forms of
+// semver.ParseWith ("2:1.4.0", "1.2.3.4") are accepted too, so every valid
+// Version marshals to a value that decodes back to it.
+// Errors wrap semver.ErrInvalidVersion and carry the node position.
+func DecodeVersion(node *yaml.Node) (semver.Semver, error) {
+	if node.Kind != yaml.ScalarNode {
+		return semver.Semver{}, yamlError(node, semver.ErrInvalidVersion, "expected a scalar")
+	}
+
+	v, ok := semver.ParseWith(node.Value, semver.ParseOptions{AllowEpoch: true, AllowRevision: true})
+	if !ok {
+		return semver.Semver{}, yamlError(node, semver.ErrInvalidVersion, fmt.Sprintf("%q", node.Value))
+	}
+
+	return v, nil
+}
+
+// DecodeConstraint parses a constraint from a scalar node.
+// Errors wrap semver.ErrInvalidConstraint and carry the node position.
+func DecodeConstraint(node *yaml.Node) (semver.Constraint, error) {
+	if node.Kind != yaml.ScalarNode {
+		return semver.Constraint{}, yamlError(node, semver.ErrInvalidConstraint, "expected a scalar")
+	}
+
+	c, ok := semver.ParseConstraint(node.Value)
+	if !ok {
+		return semver.Constraint{}, yamlError(node, semver.ErrInvalidConstraint, fmt.Sprintf("%q", node.Value))
+	}
+
+	return c, nil
+}
+
+// yamlError formats a decoding error as "yaml: line L, column C: ERR: detail".
+func yamlError(node *yaml.Node, err error, detail string) error {
+	return fmt.Errorf("yaml: line %d, column %d: %w: %s", node.Line, node.Column, err, detail)
+}
@@ -0,0 +1,101 @@
+package yamlsemver
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/woozymasta/semver"
+	"gopkg.in/yaml.v3"
+)
+
+// TestYAML_RoundTrip checks decoding and encoding of chart-like documents.
+func TestYAML_RoundTrip(t *testing.T) {
+	type chart struct {
+		Version     Version    `yaml:"version"`
+		AppVersion  Version    `yaml:"appVersion"`
+		KubeVersion Constraint `yaml:"kubeVersion"`
+		Missing     Version    `yaml:"missing"`
+	}
+
+	in := "version: 1.2\nappVersion: v2.0.0-rc.1+b5\nkubeVersion: '>=1.25 <1.31'\n"
+
+	var c chart
+	if err := yaml.Unmarshal([]byte(in), &c); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if c.Version.Original != "1.2" || c.Version.Minor != 2 || c.AppVersion.Build != "b5" {
+		t.Fatalf("decoded %+v", c)
+	}
+	if c.Missing.Valid || !c.KubeVersion.Check(semver.MustParse("1.30.2")) {
+		t.Fatalf("decoded %+v", c)
+	}
+
+	out, err := yaml.Marshal(c)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	want := "version: \"1.2\"\nappVersion: v2.0.0-rc.1+b5\nkubeVersion: '>=1.25 <1.31'\nmissing: null\n"
+	if string(out) != want {
+		t.Errorf("Marshal =\n%s\nwant\n%s", out, want)
+	}
+}
+
+// TestYAML_EpochRevision checks that ParseWith forms survive a round trip.
+func TestYAML_EpochRevision(t *testing.T) {
+	v, ok := semver.ParseWith("2:1.2.3.4-rc.1", semver.ParseOptions{AllowEpoch: true, AllowRevision: true})
+	if !ok {
+		t.Fatal("ParseWith failed")
+	}
+
+	out, err := yaml.Marshal(Version{v})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var got Version
+	if err := yaml.Unmarshal(out, &got); err != nil || got.Semver != v {
+		t.Fatalf("round trip %q: got %+v, %v; want %+v", out, got.Semver, err, v)
+	}
+}
+
+// TestYAML_Compact checks that versions without Original still encode.
+func TestYAML_Compact(t *testing.T) {
+	v := semver.MustParse("v1.2.3-rc.1+b.5").Compact()
+
+	out, err := yaml.Marshal(Version{v})
+	if err != nil || string(out) != "v1.2.3-rc.1+b.5\n" {
+		t.Fatalf("Marshal = %q, %v", out, err)
+	}
+
+	var got Version
+	if err := yaml.Unmarshal(out, &got); err != nil || !got.IsEqual(v) || got.Build != v.Build || got.Flags != v.Flags {
+		t.Fatalf("round trip %q: got %+v, %v; want %+v", out, got.Semver, err, v)
+	}
+}
+
+// TestYAML_Errors checks that errors wrap the sentinel and point to the node.
+func TestYAML_Errors(t *testing.T) {
+	var doc struct {
+		V Version    `yaml:"v"`
+		C Constraint `yaml:"c"`
+	}
+
+	tests := []struct {
+		in   string
+		want error
+		pos  string
+	}{
+		{"c: ^1\n\nv: 01.2", semver.ErrInvalidVersion, "line 3, column 4"},
+		{"v: [1, 2]", semver.ErrInvalidVersion, "line 1, column 4"},
+		{"c: '>='", semver.ErrInvalidConstraint, "line 1, column 4"},
+	}
+
+	for _, tt := range tests {
+		err := yaml.Unmarshal([]byte(tt.in), &doc)
+		if !errors.Is(err, tt.want) || !strings.Contains(err.Error(), tt.pos) {
+			t.Errorf("Unmarshal(%q) error = %v, want %v at %s", tt.in, err, tt.want, tt.pos)
+		}
+	}
+}